@@ -0,0 +1,89 @@
+// Package avalanche parses and formats Avalanche CB58 identifiers:
+// transaction, asset and chain IDs, short IDs and "NodeID-" node IDs.
+// Bech32 addresses such as "X-avax1..." are not Base58 and are not handled.
+package avalanche
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jnst/base58"
+)
+
+const (
+	// IDLen is the length of a transaction, asset, block or chain ID in bytes
+	IDLen = 32
+	// ShortIDLen is the length of a short ID or node ID in bytes
+	ShortIDLen = 20
+	// NodeIDPrefix starts every encoded node ID
+	NodeIDPrefix = "NodeID-"
+)
+
+var (
+	// ErrInvalidLength is returned when the decoded ID has the wrong length
+	ErrInvalidLength = errors.New("avalanche: invalid ID length")
+	// ErrMissingPrefix is returned when a node ID lacks the "NodeID-" prefix
+	ErrMissingPrefix = errors.New("avalanche: node ID must start with " + NodeIDPrefix)
+)
+
+// ID is a 32-byte transaction, asset, block or chain ID
+type ID [IDLen]byte
+
+// ShortID is a 20-byte identifier, such as an address hash
+type ShortID [ShortIDLen]byte
+
+// NodeID is a 20-byte validator node identifier
+type NodeID [ShortIDLen]byte
+
+// ParseID decodes a CB58 ID
+func ParseID(s string) (ID, error) {
+	var id ID
+	err := decode(id[:], s)
+	return id, err
+}
+
+// ParseShortID decodes a CB58 short ID
+func ParseShortID(s string) (ShortID, error) {
+	var id ShortID
+	err := decode(id[:], s)
+	return id, err
+}
+
+// ParseNodeID decodes a "NodeID-" prefixed CB58 node ID
+func ParseNodeID(s string) (NodeID, error) {
+	var id NodeID
+	if !strings.HasPrefix(s, NodeIDPrefix) {
+		return id, ErrMissingPrefix
+	}
+	err := decode(id[:], s[len(NodeIDPrefix):])
+	return id, err
+}
+
+// String returns the CB58 encoding of the ID
+func (id ID) String() string {
+	return base58.CB58Encode(id[:])
+}
+
+// String returns the CB58 encoding of the short ID
+func (id ShortID) String() string {
+	return base58.CB58Encode(id[:])
+}
+
+// String returns the "NodeID-" prefixed CB58 encoding of the node ID
+func (id NodeID) String() string {
+	return NodeIDPrefix + base58.CB58Encode(id[:])
+}
+
+// decode fills dst with the CB58 payload of s, which must be exactly len(dst) bytes
+func decode(dst []byte, s string) error {
+	data, err := base58.CB58Decode(s)
+	if err != nil {
+		return fmt.Errorf("avalanche: %w", err)
+	}
+	if len(data) != len(dst) {
+		return fmt.Errorf("%w: got %d bytes, want %d", ErrInvalidLength, len(data), len(dst))
+	}
+	copy(dst, data)
+	return nil
+}
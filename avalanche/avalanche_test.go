@@ -0,0 +1,86 @@
+package avalanche
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jnst/base58"
+)
+
+func TestEmptyIDs(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"ID", ID{}.String(), "11111111111111111111111111111111LpoYY"},
+		{"ShortID", ShortID{}.String(), "111111111111111111116DBWJs"},
+		{"NodeID", NodeID{}.String(), "NodeID-111111111111111111116DBWJs"},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("empty %s = %q, want %q", tt.name, tt.got, tt.expected)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	var id ID
+	var nodeID NodeID
+	for i := range id {
+		id[i] = byte(i * 7)
+	}
+	for i := range nodeID {
+		nodeID[i] = byte(255 - i)
+	}
+
+	parsedID, err := ParseID(id.String())
+	if err != nil || parsedID != id {
+		t.Errorf("ParseID(%q) = %x, %v, want %x", id, parsedID, err, id)
+	}
+
+	parsedNode, err := ParseNodeID(nodeID.String())
+	if err != nil || parsedNode != nodeID {
+		t.Errorf("ParseNodeID(%q) = %x, %v, want %x", nodeID, parsedNode, err, nodeID)
+	}
+
+	short := ShortID(nodeID)
+	parsedShort, err := ParseShortID(short.String())
+	if err != nil || parsedShort != short {
+		t.Errorf("ParseShortID(%q) = %x, %v, want %x", short, parsedShort, err, short)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{"node ID without prefix", ErrMissingPrefix, func() error {
+			_, err := ParseNodeID("111111111111111111116DBWJs")
+			return err
+		}},
+		{"short ID as ID", ErrInvalidLength, func() error {
+			_, err := ParseID("111111111111111111116DBWJs")
+			return err
+		}},
+		{"bad checksum", base58.ErrChecksum, func() error {
+			_, err := ParseID("11111111111111111111111111111111LpoYZ")
+			return err
+		}},
+		{"Base58Check checksum", base58.ErrChecksum, func() error {
+			_, err := ParseShortID(base58.CheckEncode(nil, make([]byte, ShortIDLen)))
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.run(); !errors.Is(err, tt.err) {
+				t.Errorf("error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
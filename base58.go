@@ -1,65 +1,140 @@
 package base58
 
 import (
+	"encoding/binary"
 	"errors"
-	"math/big"
-	"strings"
+	"math/bits"
 	"sync"
 )
 
 const (
-	alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
-	base58   = 58
+	base58 = 58
+	// invalidIndex marks bytes that are not part of an alphabet in decodeMap
+	invalidIndex = 0xFF
 	// Buffer size calculation: log(256)/log(58) ≈ 1.3658
 	bufferSizeMultiplier = 1366
 	bufferSizeDivisor    = 1000
 	bufferSizeExtra      = 2
+	// Decoded size calculation: log(58)/log(256) ≈ 0.7322
+	decodedSizeMultiplier = 733
+	// Conversion works on 64-bit limbs holding ten Base58 digits (58^10 fits
+	// in 59 bits) or eight bytes
+	limbBase   = 430804206899405824 // 58^10
+	limbDigits = 10
+	limbBytes  = 8
+	// Buffers larger than this are not returned to the pool
+	maxPooledBufferSize = 1 << 16
 )
 
-var alphabetMap = make(map[byte]int)
+// Standard alphabets
+const (
+	// BitcoinAlphabet is the alphabet used by Bitcoin and most other projects
+	BitcoinAlphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	// RippleAlphabet is the alphabet used by the XRP Ledger
+	RippleAlphabet = "rpshnaf39wBUDNEGHJKLM4PQRST7VWXYZ2bcdeCg65jkm8oFqi1tuvAxyz"
+	// FlickrAlphabet is the alphabet used by Flickr short URLs
+	FlickrAlphabet = "123456789abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ"
+)
+
+// Predefined encodings
+var (
+	// BitcoinEncoding is the Base58 encoding with the Bitcoin alphabet
+	BitcoinEncoding = mustNewEncoding(BitcoinAlphabet)
+	// RippleEncoding is the Base58 encoding with the Ripple alphabet
+	RippleEncoding = mustNewEncoding(RippleAlphabet)
+	// FlickrEncoding is the Base58 encoding with the Flickr alphabet
+	FlickrEncoding = mustNewEncoding(FlickrAlphabet)
+)
 
-// Pool for reusing big.Int objects to reduce allocations
-var bigIntPool = sync.Pool{
+// Encoding is a Base58 encoding defined by a 58-character alphabet.
+// An Encoding is safe for concurrent use.
+type Encoding struct {
+	encode    [base58]byte
+	decodeMap [256]byte
+}
+
+// Pool for reusing limb scratch space
+var limbPool = sync.Pool{
 	New: func() any {
-		return new(big.Int)
+		return new([]uint64)
 	},
 }
 
-// Pool for reusing strings.Builder objects
-var stringBuilderPool = sync.Pool{
+// Pool for reusing output buffers
+var bufferPool = sync.Pool{
 	New: func() any {
-		return &strings.Builder{}
+		return new([]byte)
 	},
 }
 
-func init() {
-	for i, char := range []byte(alphabet) {
-		alphabetMap[char] = i
+// NewEncoding returns a new Encoding defined by the given alphabet,
+// which must be 58 unique bytes
+func NewEncoding(alphabet string) (*Encoding, error) {
+	if len(alphabet) != base58 {
+		return nil, ErrInvalidAlphabetLength
 	}
+
+	enc := &Encoding{}
+	for i := range enc.decodeMap {
+		enc.decodeMap[i] = invalidIndex
+	}
+	for i := 0; i < len(alphabet); i++ {
+		char := alphabet[i]
+		if enc.decodeMap[char] != invalidIndex {
+			return nil, ErrDuplicateAlphabetChar
+		}
+		enc.encode[i] = char
+		enc.decodeMap[char] = byte(i)
+	}
+	return enc, nil
 }
 
-// getBigInt gets a big.Int from the pool
-func getBigInt() *big.Int {
-	bi := bigIntPool.Get().(*big.Int) //nolint:errcheck
-	return bi
+// mustNewEncoding is like NewEncoding but panics on an invalid alphabet
+func mustNewEncoding(alphabet string) *Encoding {
+	enc, err := NewEncoding(alphabet)
+	if err != nil {
+		panic(err)
+	}
+	return enc
 }
 
-// putBigInt returns a big.Int to the pool after resetting it
-func putBigInt(bi *big.Int) {
-	bi.SetInt64(0) // Reset to zero
-	bigIntPool.Put(bi)
+// Alphabet returns the alphabet of the encoding
+func (enc *Encoding) Alphabet() string {
+	return string(enc.encode[:])
 }
 
-// getStringBuilder gets a strings.Builder from the pool
-func getStringBuilder() *strings.Builder {
-	sb := stringBuilderPool.Get().(*strings.Builder) //nolint:errcheck
-	return sb
+// getLimbs gets a zeroed limb slice of length n from the pool
+func getLimbs(n int) *[]uint64 {
+	limbs := limbPool.Get().(*[]uint64) //nolint:errcheck
+	if cap(*limbs) < n {
+		*limbs = make([]uint64, n)
+	}
+	*limbs = (*limbs)[:n]
+	for i := range *limbs {
+		(*limbs)[i] = 0
+	}
+	return limbs
 }
 
-// putStringBuilder returns a strings.Builder to the pool after resetting it
-func putStringBuilder(sb *strings.Builder) {
-	sb.Reset()
-	stringBuilderPool.Put(sb)
+// putLimbs returns a limb slice to the pool
+func putLimbs(limbs *[]uint64) {
+	if cap(*limbs)*limbBytes <= maxPooledBufferSize {
+		limbPool.Put(limbs)
+	}
+}
+
+// getBuffer gets an empty byte buffer from the pool
+func getBuffer() *[]byte {
+	buf := bufferPool.Get().(*[]byte) //nolint:errcheck
+	*buf = (*buf)[:0]
+	return buf
+}
+
+// putBuffer returns a byte buffer to the pool
+func putBuffer(buf *[]byte) {
+	if cap(*buf) <= maxPooledBufferSize {
+		bufferPool.Put(buf)
+	}
 }
 
 // calculateOptimalBufferSize calculates a more accurate buffer size
@@ -71,119 +146,286 @@ func calculateOptimalBufferSize(dataLen int) int {
 	return (dataLen*bufferSizeMultiplier)/bufferSizeDivisor + bufferSizeExtra
 }
 
-// Encode encodes byte data to Base58 string using optimized implementation
+// EncodedLen returns the maximum length of the Base58 encoding of n bytes
+func EncodedLen(n int) int {
+	return calculateOptimalBufferSize(n)
+}
+
+// DecodedLen returns the maximum number of bytes decoded from n Base58
+// characters. Each leading zero digit decodes to one byte and every other
+// digit to less than one.
+func DecodedLen(n int) int {
+	return n
+}
+
+// AppendEncode appends the Base58 encoding of src with the Bitcoin alphabet to dst
+func AppendEncode(dst, src []byte) []byte {
+	return BitcoinEncoding.AppendEncode(dst, src)
+}
+
+// AppendDecode appends the bytes decoded from src with the Bitcoin alphabet to dst
+func AppendDecode(dst, src []byte) ([]byte, error) {
+	return BitcoinEncoding.AppendDecode(dst, src)
+}
+
+// Encode encodes byte data to Base58 string with the Bitcoin alphabet
 func Encode(data []byte) string {
+	return BitcoinEncoding.Encode(data)
+}
+
+// Decode decodes Base58 string with the Bitcoin alphabet to byte data
+func Decode(s string) ([]byte, error) {
+	return BitcoinEncoding.Decode(s)
+}
+
+// AppendEncode appends the Base58 encoding of src to dst and returns the
+// extended buffer. It does not allocate when dst has EncodedLen(len(src))
+// bytes of spare capacity.
+func (enc *Encoding) AppendEncode(dst, src []byte) []byte {
+	return enc.appendEncode(dst, src)
+}
+
+// AppendDecode appends the bytes decoded from src to dst and returns the
+// extended buffer. It does not allocate when dst has DecodedLen(len(src))
+// bytes of spare capacity. On error dst is returned unchanged.
+func (enc *Encoding) AppendDecode(dst, src []byte) ([]byte, error) {
+	return appendDecode(enc, dst, src)
+}
+
+// Encode encodes byte data to Base58 string using optimized implementation
+func (enc *Encoding) Encode(data []byte) string {
 	if len(data) == 0 {
 		return ""
 	}
 
-	// Count leading zeros
+	buf := getBuffer()
+	defer putBuffer(buf)
+
+	*buf = enc.appendEncode(*buf, data)
+	return string(*buf)
+}
+
+// Decode decodes Base58 string to byte data using optimized implementation
+func (enc *Encoding) Decode(s string) ([]byte, error) {
+	if s == "" {
+		return []byte{}, nil
+	}
+
+	// Strings of the typical length of 32- and 64-byte values try the
+	// fixed-size path first and fall back when they decode to another length
+	switch {
+	case len(s) >= encoded32Len-1 && len(s) <= encoded32Len:
+		var fixed [32]byte
+		if err := enc.decode32(&fixed, s); !errors.Is(err, ErrInvalidLength) {
+			return fixedResult(fixed[:], err)
+		}
+	case len(s) >= encoded64Len-1 && len(s) <= encoded64Len:
+		var fixed [64]byte
+		if err := enc.decode64(&fixed, s); !errors.Is(err, ErrInvalidLength) {
+			return fixedResult(fixed[:], err)
+		}
+	}
+	return appendDecode(enc, nil, s)
+}
+
+// fixedResult copies a value decoded on the fixed-size path to the heap
+func fixedResult(fixed []byte, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	result := make([]byte, len(fixed))
+	copy(result, fixed)
+	return result, nil
+}
+
+// appendEncode appends the Base58 encoding of data to dst
+func (enc *Encoding) appendEncode(dst, data []byte) []byte {
+	switch len(data) {
+	case 32:
+		return enc.appendEncode32(dst, (*[32]byte)(data))
+	case 64:
+		return enc.appendEncode64(dst, (*[64]byte)(data))
+	}
+
+	// Leading zero bytes map one-to-one to the zero digit
 	leading := 0
 	for leading < len(data) && data[leading] == 0 {
 		leading++
 	}
+	for i := 0; i < leading; i++ {
+		dst = append(dst, enc.encode[0])
+	}
 
-	// Special case: all zeros
-	if leading == len(data) {
-		sb := getStringBuilder()
-		defer putStringBuilder(sb)
+	data = data[leading:]
+	if len(data) == 0 {
+		return dst
+	}
 
-		sb.Grow(leading)
-		for i := 0; i < leading; i++ {
-			sb.WriteByte(alphabet[0])
+	limbsPtr := getLimbs(calculateOptimalBufferSize(len(data))/limbDigits + 1)
+	defer putLimbs(limbsPtr)
+	limbs := *limbsPtr
+
+	// Convert big-endian bytes to little-endian base 58^10 limbs, feeding up
+	// to eight input bytes per pass. The first pass takes the odd bytes so
+	// that every following pass reads a whole uint64.
+	used := 0
+	head := len(data) % limbBytes
+	if head == 0 {
+		head = limbBytes
+	}
+	for i := 0; i < len(data); {
+		var carry uint64
+		n := limbBytes
+		if i == 0 {
+			n = head
+		}
+		for _, b := range data[i : i+n] {
+			carry = carry<<8 | uint64(b)
+		}
+		shift := uint(8 * n)
+		i += n
+
+		for j := 0; j < used; j++ {
+			// limbs[j]<<shift + carry as a 128-bit value; the quotient
+			// fits in 64 bits because limbs[j] < 58^10
+			hi := limbs[j] >> (64 - shift)
+			lo := limbs[j]<<shift | carry
+			carry, limbs[j] = bits.Div64(hi, lo, limbBase)
+		}
+		for carry > 0 {
+			limbs[used] = carry % limbBase
+			carry /= limbBase
+			used++
 		}
-		return sb.String()
 	}
 
-	// Calculate optimal buffer size
-	size := calculateOptimalBufferSize(len(data) - leading)
-	encoded := make([]byte, size)
-
-	// Get big.Int objects from pool
-	bigInt := getBigInt()
-	baseInt := getBigInt()
-	zero := getBigInt()
-	mod := getBigInt()
-
-	defer func() {
-		putBigInt(bigInt)
-		putBigInt(baseInt)
-		putBigInt(zero)
-		putBigInt(mod)
-	}()
+	// The most significant limb is written without leading zero digits,
+	// every other limb as exactly ten digits
+	var digits [limbDigits]byte
+	top := limbs[used-1]
+	n := 0
+	for top > 0 {
+		digits[n] = enc.encode[top%base58]
+		top /= base58
+		n++
+	}
+	for n > 0 {
+		n--
+		dst = append(dst, digits[n])
+	}
+	for j := used - 2; j >= 0; j-- {
+		limb := limbs[j]
+		for k := limbDigits - 1; k >= 0; k-- {
+			digits[k] = enc.encode[limb%base58]
+			limb /= base58
+		}
+		dst = append(dst, digits[:]...)
+	}
 
-	bigInt.SetBytes(data[leading:])
-	baseInt.SetInt64(base58)
-	zero.SetInt64(0)
+	return dst
+}
 
-	pos := size - 1
-	for bigInt.Cmp(zero) > 0 {
-		bigInt.DivMod(bigInt, baseInt, mod)
-		encoded[pos] = alphabet[mod.Int64()]
-		pos--
+// appendDecode appends the bytes decoded from s to dst. It is generic so
+// that string and []byte input share one implementation without conversion.
+func appendDecode[T string | []byte](enc *Encoding, dst []byte, s T) ([]byte, error) {
+	leading, limbs, scratch, err := decodeLimbs(enc, s)
+	defer putLimbs(scratch)
+	if err != nil {
+		return dst, err
 	}
 
-	// Build result string efficiently
-	sb := getStringBuilder()
-	defer putStringBuilder(sb)
-
-	// Pre-allocate capacity
-	resultLen := leading + (size - pos - 1)
-	sb.Grow(resultLen)
+	size, topBytes := decodedSize(leading, limbs)
+	dst = grow(dst, size)
 
-	// Add leading zeros
 	for i := 0; i < leading; i++ {
-		sb.WriteByte(alphabet[0])
+		dst = append(dst, 0)
+	}
+	if len(limbs) == 0 {
+		return dst, nil
+	}
+	top := limbs[len(limbs)-1]
+	for k := topBytes - 1; k >= 0; k-- {
+		dst = append(dst, byte(top>>(8*k)))
+	}
+	var word [limbBytes]byte
+	for j := len(limbs) - 2; j >= 0; j-- {
+		binary.BigEndian.PutUint64(word[:], limbs[j])
+		dst = append(dst, word[:]...)
 	}
 
-	// Add encoded part
-	sb.Write(encoded[pos+1:])
-
-	return sb.String()
+	return dst, nil
 }
 
-// Decode decodes Base58 string to byte data using optimized implementation
-func Decode(s string) ([]byte, error) {
-	if s == "" {
-		return []byte{}, nil
-	}
-
-	// Count leading '1's
-	leading := 0
-	for leading < len(s) && s[leading] == alphabet[0] {
+// decodeLimbs converts s to little-endian base 2^64 limbs. It returns the
+// number of leading zero digits, the significant limbs and the pooled
+// scratch space holding them, which the caller must return with putLimbs.
+func decodeLimbs[T string | []byte](enc *Encoding, s T) (leading int, limbs []uint64, scratch *[]uint64, err error) {
+	// Leading zero digits map one-to-one to zero bytes
+	for leading < len(s) && s[leading] == enc.encode[0] {
 		leading++
 	}
+	rest := s[leading:]
+
+	scratch = getLimbs((len(rest)*decodedSizeMultiplier/bufferSizeDivisor)/limbBytes + 1)
+	limbs = *scratch
+
+	// Convert Base58 digits to little-endian base 2^64 limbs, feeding up to
+	// ten digits per pass. As with encoding, the first pass takes the odd
+	// digits so that every following pass multiplies by 58^10.
+	used := 0
+	head := len(rest) % limbDigits
+	if head == 0 {
+		head = limbDigits
+	}
+	for i := 0; i < len(rest); {
+		n := limbDigits
+		if i == 0 {
+			n = head
+		}
+		var carry uint64
+		mul := uint64(1)
+		for k := i; k < i+n; k++ {
+			digit := enc.decodeMap[rest[k]]
+			if digit == invalidIndex {
+				return leading, nil, scratch, corruptInputError(string(s), leading+k)
+			}
+			carry = carry*base58 + uint64(digit)
+			mul *= base58
+		}
+		i += n
 
-	// Get big.Int objects from pool
-	bigInt := getBigInt()
-	baseInt := getBigInt()
-	temp := getBigInt()
-
-	defer func() {
-		putBigInt(bigInt)
-		putBigInt(baseInt)
-		putBigInt(temp)
-	}()
-
-	bigInt.SetInt64(0)
-	baseInt.SetInt64(base58)
-
-	// Process non-leading characters
-	for _, char := range []byte(s[leading:]) {
-		value, ok := alphabetMap[char]
-		if !ok {
-			return nil, errors.New("invalid base58 character")
+		for j := 0; j < used; j++ {
+			hi, lo := bits.Mul64(limbs[j], mul)
+			lo, c := bits.Add64(lo, carry, 0)
+			limbs[j], carry = lo, hi+c
+		}
+		if carry > 0 {
+			limbs[used] = carry
+			used++
 		}
-		bigInt.Mul(bigInt, baseInt)
-		temp.SetInt64(int64(value))
-		bigInt.Add(bigInt, temp)
 	}
 
-	decoded := bigInt.Bytes()
+	return leading, limbs[:used], scratch, nil
+}
 
-	// Prepare result with leading zeros
-	result := make([]byte, leading+len(decoded))
-	copy(result[leading:], decoded)
+// decodedSize returns the exact decoded length, which is the leading zeros,
+// the significant bytes of the top limb and eight bytes for every other limb
+func decodedSize(leading int, limbs []uint64) (size, topBytes int) {
+	if len(limbs) == 0 {
+		return leading, 0
+	}
+	for top := limbs[len(limbs)-1]; top > 0; top >>= 8 {
+		topBytes++
+	}
+	return leading + topBytes + (len(limbs)-1)*limbBytes, topBytes
+}
 
-	return result, nil
+// grow ensures dst has room for n more bytes
+func grow(dst []byte, n int) []byte {
+	if cap(dst)-len(dst) >= n {
+		return dst
+	}
+	grown := make([]byte, len(dst), len(dst)+n)
+	copy(grown, dst)
+	return grown
 }
@@ -227,3 +227,87 @@ func BenchmarkDecode_32B_Allocs(b *testing.B) {
 		_, _ = Decode(encoded) //nolint:errcheck
 	}
 }
+
+// Append benchmarks with preallocated buffers
+func BenchmarkAppendEncode_32B(b *testing.B) {
+	data := generateRandomBytes(32)
+	buf := make([]byte, 0, EncodedLen(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = AppendEncode(buf, data)
+	}
+}
+
+func BenchmarkAppendEncode_1KB(b *testing.B) {
+	data := generateRandomBytes(1024)
+	buf := make([]byte, 0, EncodedLen(len(data)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = AppendEncode(buf, data)
+	}
+}
+
+func BenchmarkAppendDecode_32B(b *testing.B) {
+	encoded := []byte(Encode(generateRandomBytes(32)))
+	buf := make([]byte, 0, DecodedLen(len(encoded)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = AppendDecode(buf, encoded) //nolint:errcheck
+	}
+}
+
+func BenchmarkAppendDecode_1KB(b *testing.B) {
+	encoded := []byte(Encode(generateRandomBytes(1024)))
+	buf := make([]byte, 0, DecodedLen(len(encoded)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = AppendDecode(buf, encoded) //nolint:errcheck
+	}
+}
+
+// Fixed-size benchmarks, comparable with BenchmarkEncode_32B/64B
+func BenchmarkEncode32(b *testing.B) {
+	var key [32]byte
+	copy(key[:], generateRandomBytes(32))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Encode32(key)
+	}
+}
+
+func BenchmarkDecode32(b *testing.B) {
+	var key [32]byte
+	copy(key[:], generateRandomBytes(32))
+	encoded := Encode32(key)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Decode32(encoded) //nolint:errcheck
+	}
+}
+
+func BenchmarkEncode64(b *testing.B) {
+	var sig [64]byte
+	copy(sig[:], generateRandomBytes(64))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Encode64(sig)
+	}
+}
+
+func BenchmarkDecode64(b *testing.B) {
+	var sig [64]byte
+	copy(sig[:], generateRandomBytes(64))
+	encoded := Encode64(sig)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Decode64(encoded) //nolint:errcheck
+	}
+}
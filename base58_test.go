@@ -1,6 +1,11 @@
 package base58
 
 import (
+	"bytes"
+	"errors"
+	"math/big"
+	mrand "math/rand"
+	"strings"
 	"testing"
 )
 
@@ -270,3 +275,271 @@ func TestEncodeDecodeRoundTrip(t *testing.T) {
 		})
 	}
 }
+
+func TestNewEncoding(t *testing.T) {
+	tests := []struct {
+		name     string
+		alphabet string
+		err      error
+	}{
+		{
+			name:     "bitcoin alphabet",
+			alphabet: BitcoinAlphabet,
+			err:      nil,
+		},
+		{
+			name:     "too short",
+			alphabet: BitcoinAlphabet[1:],
+			err:      ErrInvalidAlphabetLength,
+		},
+		{
+			name:     "too long",
+			alphabet: BitcoinAlphabet + "0",
+			err:      ErrInvalidAlphabetLength,
+		},
+		{
+			name:     "duplicate character",
+			alphabet: "1" + BitcoinAlphabet[1:57] + "1",
+			err:      ErrDuplicateAlphabetChar,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := NewEncoding(tt.alphabet)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("NewEncoding(%q) error = %v, want %v", tt.alphabet, err, tt.err)
+			}
+			if err == nil && enc.Alphabet() != tt.alphabet {
+				t.Errorf("Alphabet() = %q, want %q", enc.Alphabet(), tt.alphabet)
+			}
+		})
+	}
+}
+
+func TestEncodingAlphabets(t *testing.T) {
+	encodings := []struct {
+		name string
+		enc  *Encoding
+	}{
+		{"ripple", RippleEncoding},
+		{"flickr", FlickrEncoding},
+	}
+	inputs := [][]byte{
+		{0x00},
+		{0x00, 0x00, 0x01},
+		[]byte("Hello World"),
+		{0xFF, 0xEE, 0xDD, 0xCC, 0xBB, 0xAA},
+	}
+
+	for _, e := range encodings {
+		// Every alphabet encodes the same digits, so the result is a
+		// character-by-character translation of the Bitcoin encoding
+		translate := func(r rune) rune {
+			return rune(e.enc.Alphabet()[strings.IndexRune(BitcoinAlphabet, r)])
+		}
+
+		for _, input := range inputs {
+			t.Run(e.name, func(t *testing.T) {
+				expected := strings.Map(translate, Encode(input))
+				encoded := e.enc.Encode(input)
+				if encoded != expected {
+					t.Fatalf("Encode(%v) = %q, want %q", input, encoded, expected)
+				}
+
+				decoded, err := e.enc.Decode(encoded)
+				if err != nil {
+					t.Fatalf("Decode(%q) unexpected error: %v", encoded, err)
+				}
+				if string(decoded) != string(input) {
+					t.Errorf("Decode(%q) = %v, want %v", encoded, decoded, input)
+				}
+			})
+		}
+	}
+}
+
+func TestEncodingRejectsForeignCharacters(t *testing.T) {
+	// '0' and 'l' are excluded from every standard alphabet
+	if _, err := FlickrEncoding.Decode("abc0"); err == nil {
+		t.Errorf("Decode(%q) expected error, got nil", "abc0")
+	}
+	if _, err := RippleEncoding.Decode("rpl"); err == nil {
+		t.Errorf("Decode(%q) expected error, got nil", "rpl")
+	}
+}
+
+// referenceEncode is the original math/big implementation, kept to verify
+// the limb-based codec produces identical output
+func referenceEncode(data []byte) string {
+	leading := 0
+	for leading < len(data) && data[leading] == 0 {
+		leading++
+	}
+
+	value := new(big.Int).SetBytes(data[leading:])
+	base := big.NewInt(base58)
+	mod := new(big.Int)
+
+	var reversed []byte
+	for value.Sign() > 0 {
+		value.DivMod(value, base, mod)
+		reversed = append(reversed, BitcoinAlphabet[mod.Int64()])
+	}
+
+	result := strings.Repeat("1", leading)
+	for i := len(reversed) - 1; i >= 0; i-- {
+		result += string(reversed[i])
+	}
+	return result
+}
+
+// referenceDecode is the original math/big implementation of Decode
+func referenceDecode(s string) ([]byte, error) {
+	leading := 0
+	for leading < len(s) && s[leading] == '1' {
+		leading++
+	}
+
+	value := new(big.Int)
+	base := big.NewInt(base58)
+	for _, char := range []byte(s[leading:]) {
+		index := strings.IndexByte(BitcoinAlphabet, char)
+		if index < 0 {
+			return nil, ErrInvalidCharacter
+		}
+		value.Mul(value, base)
+		value.Add(value, big.NewInt(int64(index)))
+	}
+
+	decoded := value.Bytes()
+	result := make([]byte, leading+len(decoded))
+	copy(result[leading:], decoded)
+	return result, nil
+}
+
+func TestEncodeMatchesReference(t *testing.T) {
+	rng := mrand.New(mrand.NewSource(1))
+
+	for size := 0; size <= 600; size++ {
+		data := make([]byte, size)
+		rng.Read(data)
+		// Exercise leading zero handling on a share of the inputs
+		if size > 0 && size%3 == 0 {
+			zeros := rng.Intn(size)
+			for i := 0; i < zeros; i++ {
+				data[i] = 0
+			}
+		}
+
+		expected := referenceEncode(data)
+		if encoded := Encode(data); encoded != expected {
+			t.Fatalf("Encode(%x) = %q, want %q", data, encoded, expected)
+		}
+	}
+}
+
+func TestDecodeMatchesReference(t *testing.T) {
+	rng := mrand.New(mrand.NewSource(2))
+
+	for size := 1; size <= 800; size++ {
+		encoded := make([]byte, size)
+		for i := range encoded {
+			encoded[i] = BitcoinAlphabet[rng.Intn(base58)]
+		}
+		if size%4 == 0 {
+			zeros := rng.Intn(size)
+			for i := 0; i < zeros; i++ {
+				encoded[i] = '1'
+			}
+		}
+
+		expected, err := referenceDecode(string(encoded))
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := Decode(string(encoded))
+		if err != nil {
+			t.Fatalf("Decode(%q) unexpected error: %v", encoded, err)
+		}
+		if !bytes.Equal(decoded, expected) {
+			t.Fatalf("Decode(%q) = %x, want %x", encoded, decoded, expected)
+		}
+	}
+}
+
+func TestAppendEncode(t *testing.T) {
+	dst := []byte("prefix:")
+	result := AppendEncode(dst, []byte("Hello World"))
+	if string(result) != "prefix:JxF12TrwUP45BMd" {
+		t.Errorf("AppendEncode = %q, want %q", result, "prefix:JxF12TrwUP45BMd")
+	}
+
+	result = AppendEncode(nil, []byte{0x00, 0x00})
+	if string(result) != "11" {
+		t.Errorf("AppendEncode = %q, want %q", result, "11")
+	}
+}
+
+func TestAppendDecode(t *testing.T) {
+	dst := []byte{0xAA}
+	result, err := AppendDecode(dst, []byte("17bWpTW"))
+	if err != nil {
+		t.Fatalf("AppendDecode unexpected error: %v", err)
+	}
+	expected := []byte{0xAA, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05}
+	if !bytes.Equal(result, expected) {
+		t.Errorf("AppendDecode = %x, want %x", result, expected)
+	}
+
+	result, err = AppendDecode(dst, []byte("12l"))
+	if !errors.Is(err, ErrInvalidCharacter) {
+		t.Errorf("AppendDecode error = %v, want ErrInvalidCharacter", err)
+	}
+	if !bytes.Equal(result, dst) {
+		t.Errorf("AppendDecode on error = %x, want %x", result, dst)
+	}
+}
+
+func TestEncodedLen(t *testing.T) {
+	for n := 0; n <= 512; n++ {
+		for _, fill := range []byte{0x00, 0xFF} {
+			encoded := Encode(bytes.Repeat([]byte{fill}, n))
+			if len(encoded) > EncodedLen(n) {
+				t.Fatalf("len(Encode(%d x %02x)) = %d, exceeds EncodedLen %d", n, fill, len(encoded), EncodedLen(n))
+			}
+		}
+	}
+}
+
+func TestDecodedLen(t *testing.T) {
+	for n := 0; n <= 512; n++ {
+		for _, fill := range []string{"1", "z"} {
+			decoded, err := Decode(strings.Repeat(fill, n))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(decoded) > DecodedLen(n) {
+				t.Fatalf("len(Decode(%d x %s)) = %d, exceeds DecodedLen %d", n, fill, len(decoded), DecodedLen(n))
+			}
+		}
+	}
+}
+
+func TestAppendAllocs(t *testing.T) {
+	data := generateRandomBytes(32)
+	encoded := []byte(Encode(data))
+	encodeBuf := make([]byte, 0, EncodedLen(len(data)))
+	decodeBuf := make([]byte, 0, DecodedLen(len(encoded)))
+
+	if allocs := testing.AllocsPerRun(100, func() {
+		_ = AppendEncode(encodeBuf, data)
+	}); allocs != 0 {
+		t.Errorf("AppendEncode allocs = %v, want 0", allocs)
+	}
+	if allocs := testing.AllocsPerRun(100, func() {
+		_, _ = AppendDecode(decodeBuf, encoded) //nolint:errcheck
+	}); allocs != 0 {
+		t.Errorf("AppendDecode allocs = %v, want 0", allocs)
+	}
+}
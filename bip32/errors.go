@@ -0,0 +1,34 @@
+package bip32
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUnknownVersion is returned when the version bytes are not a known
+	// BIP32 or SLIP-132 version
+	ErrUnknownVersion = errors.New("bip32: unknown version")
+	// ErrInvalidKeyLength is returned when the decoded payload is not 78 bytes
+	ErrInvalidKeyLength = errors.New("bip32: extended key must be 78 bytes")
+	// ErrInvalidKeyData is returned when the key data does not match the
+	// public or private form implied by the version
+	ErrInvalidKeyData = errors.New("bip32: invalid key data")
+	// ErrInvalidMasterKey is returned when a depth 0 key has a parent
+	// fingerprint or child number
+	ErrInvalidMasterKey = errors.New("bip32: master key with non-zero parent fingerprint or child number")
+)
+
+// VersionError reports version bytes that are not recognized
+type VersionError struct {
+	Version uint32
+}
+
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("bip32: unknown version 0x%08x", e.Version)
+}
+
+// Is reports whether target is ErrUnknownVersion
+func (e *VersionError) Is(target error) bool {
+	return target == ErrUnknownVersion
+}
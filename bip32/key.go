@@ -0,0 +1,123 @@
+// Package bip32 parses and serializes BIP32 extended keys, including the
+// SLIP-132 ypub/zpub variants.
+package bip32
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/jnst/base58"
+)
+
+const (
+	// SerializedLen is the length of a serialized extended key without checksum
+	SerializedLen = 78
+	// ChainCodeLen is the length of the chain code in bytes
+	ChainCodeLen = 32
+	// KeyDataLen is the length of the key data in bytes
+	KeyDataLen = 33
+	// HardenedOffset is the first hardened child number
+	HardenedOffset uint32 = 0x80000000
+)
+
+const versionLen = 4
+
+// ExtendedKey is a serialized BIP32 extended public or private key
+type ExtendedKey struct {
+	Version           uint32
+	Depth             uint8
+	ParentFingerprint [4]byte
+	ChildNumber       uint32
+	ChainCode         [ChainCodeLen]byte
+	// KeyData is a compressed public key, or 0x00 followed by the 32-byte
+	// private key
+	KeyData [KeyDataLen]byte
+}
+
+// Parse decodes a Base58Check extended key and validates its structure
+func Parse(s string) (*ExtendedKey, error) {
+	version, payload, err := base58.BitcoinEncoding.CheckDecode(s, versionLen)
+	if err != nil {
+		return nil, fmt.Errorf("bip32: %w", err)
+	}
+	if len(payload) != SerializedLen-versionLen {
+		return nil, ErrInvalidKeyLength
+	}
+
+	key := &ExtendedKey{
+		Version:     binary.BigEndian.Uint32(version),
+		Depth:       payload[0],
+		ChildNumber: binary.BigEndian.Uint32(payload[5:9]),
+	}
+	copy(key.ParentFingerprint[:], payload[1:5])
+	copy(key.ChainCode[:], payload[9:41])
+	copy(key.KeyData[:], payload[41:])
+
+	if err := key.validate(); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// validate checks the version and the consistency of the fields
+func (k *ExtendedKey) validate() error {
+	info, ok := versions[k.Version]
+	if !ok {
+		return &VersionError{Version: k.Version}
+	}
+
+	prefix := k.KeyData[0]
+	if info.Private {
+		if prefix != 0x00 {
+			return ErrInvalidKeyData
+		}
+	} else if prefix != 0x02 && prefix != 0x03 {
+		return ErrInvalidKeyData
+	}
+
+	if k.Depth == 0 && (k.ParentFingerprint != [4]byte{} || k.ChildNumber != 0) {
+		return ErrInvalidMasterKey
+	}
+	return nil
+}
+
+// Serialize returns the 78-byte serialization of the key
+func (k *ExtendedKey) Serialize() []byte {
+	buf := make([]byte, SerializedLen)
+	binary.BigEndian.PutUint32(buf[0:4], k.Version)
+	buf[4] = k.Depth
+	copy(buf[5:9], k.ParentFingerprint[:])
+	binary.BigEndian.PutUint32(buf[9:13], k.ChildNumber)
+	copy(buf[13:45], k.ChainCode[:])
+	copy(buf[45:], k.KeyData[:])
+	return buf
+}
+
+// String returns the Base58Check encoding of the key
+func (k *ExtendedKey) String() string {
+	buf := k.Serialize()
+	return base58.BitcoinEncoding.CheckEncode(buf[:versionLen], buf[versionLen:])
+}
+
+// Info returns the description of the key's version
+func (k *ExtendedKey) Info() (VersionInfo, bool) {
+	return LookupVersion(k.Version)
+}
+
+// IsPrivate reports whether the key holds a private key
+func (k *ExtendedKey) IsPrivate() bool {
+	return versions[k.Version].Private
+}
+
+// IsHardened reports whether the key was derived as a hardened child
+func (k *ExtendedKey) IsHardened() bool {
+	return k.ChildNumber >= HardenedOffset
+}
+
+// Key returns the 33-byte compressed public key or the 32-byte private key
+func (k *ExtendedKey) Key() []byte {
+	if k.IsPrivate() {
+		return k.KeyData[1:]
+	}
+	return k.KeyData[:]
+}
@@ -0,0 +1,154 @@
+package bip32
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jnst/base58"
+	"github.com/jnst/base58/bitcoin"
+)
+
+// Test vector 1 from BIP32
+const (
+	masterXpub = "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8"
+	masterXprv = "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"
+	childXpub  = "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		version     uint32
+		depth       uint8
+		fingerprint string
+		childNumber uint32
+		chainCode   string
+		keyData     string
+	}{
+		{
+			name:        "master public",
+			input:       masterXpub,
+			version:     VersionXpub,
+			fingerprint: "00000000",
+			chainCode:   "873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508",
+			keyData:     "0339a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2",
+		},
+		{
+			name:        "master private",
+			input:       masterXprv,
+			version:     VersionXprv,
+			fingerprint: "00000000",
+			chainCode:   "873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508",
+			keyData:     "00e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+		},
+		{
+			name:        "hardened child public",
+			input:       childXpub,
+			version:     VersionXpub,
+			depth:       1,
+			fingerprint: "3442193e",
+			childNumber: HardenedOffset,
+			chainCode:   "47fdacbd0f1097043b78c63c20c34ef4ed9a111d980047ad16282c7ae6236141",
+			keyData:     "035a784662a4a20a65bf6aab9ae98a6c068a81c52e4b032c0fb5400c706cfccc56",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if key.Version != tt.version {
+				t.Errorf("Version = %08x, want %08x", key.Version, tt.version)
+			}
+			if key.Depth != tt.depth {
+				t.Errorf("Depth = %d, want %d", key.Depth, tt.depth)
+			}
+			if got := hex.EncodeToString(key.ParentFingerprint[:]); got != tt.fingerprint {
+				t.Errorf("ParentFingerprint = %s, want %s", got, tt.fingerprint)
+			}
+			if key.ChildNumber != tt.childNumber {
+				t.Errorf("ChildNumber = %d, want %d", key.ChildNumber, tt.childNumber)
+			}
+			if got := hex.EncodeToString(key.ChainCode[:]); got != tt.chainCode {
+				t.Errorf("ChainCode = %s, want %s", got, tt.chainCode)
+			}
+			if got := hex.EncodeToString(key.KeyData[:]); got != tt.keyData {
+				t.Errorf("KeyData = %s, want %s", got, tt.keyData)
+			}
+			if key.String() != tt.input {
+				t.Errorf("String() = %q, want %q", key.String(), tt.input)
+			}
+		})
+	}
+}
+
+func TestVersionPrefixes(t *testing.T) {
+	master, err := Parse(masterXpub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for version, info := range versions {
+		key := *master
+		key.Version = version
+		if info.Private {
+			key.KeyData = [KeyDataLen]byte{0x00, 0x01}
+		}
+
+		encoded := key.String()
+		if !strings.HasPrefix(encoded, info.Prefix) {
+			t.Errorf("version %08x encodes as %q, want prefix %q", version, encoded, info.Prefix)
+		}
+
+		parsed, err := Parse(encoded)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", encoded, err)
+		}
+		if parsed.IsPrivate() != info.Private {
+			t.Errorf("%s IsPrivate() = %v, want %v", info.Prefix, parsed.IsPrivate(), info.Private)
+		}
+	}
+
+	if info, _ := LookupVersion(VersionVpub); info.Network != bitcoin.Testnet || info.Script != ScriptP2WPKH {
+		t.Errorf("LookupVersion(vpub) = %+v", info)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	master, err := Parse(masterXpub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encode := func(modify func(k *ExtendedKey)) string {
+		key := *master
+		modify(&key)
+		return key.String()
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{"bad checksum", masterXpub[:len(masterXpub)-1] + "9", base58.ErrChecksum},
+		{"unknown version", encode(func(k *ExtendedKey) { k.Version = 0x01020304 }), ErrUnknownVersion},
+		{"private version with public key", encode(func(k *ExtendedKey) { k.Version = VersionXprv }), ErrInvalidKeyData},
+		{"public version with private key", encode(func(k *ExtendedKey) { k.KeyData[0] = 0x00 }), ErrInvalidKeyData},
+		{"master with fingerprint", encode(func(k *ExtendedKey) { k.ParentFingerprint[0] = 1 }), ErrInvalidMasterKey},
+		{"short payload", base58.BitcoinEncoding.CheckEncode(master.Serialize()[:4], master.Serialize()[4:77]), ErrInvalidKeyLength},
+		{"too short", "1", base58.ErrTooShort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.input); !errors.Is(err, tt.err) {
+				t.Errorf("Parse() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package bip32
+
+import (
+	"github.com/jnst/base58/bitcoin"
+	"github.com/jnst/base58/internal/hdversion"
+)
+
+// Serialization version bytes from BIP32 and SLIP-132
+const (
+	VersionXpub uint32 = hdversion.Xpub
+	VersionXprv uint32 = hdversion.Xprv
+	VersionTpub uint32 = hdversion.Tpub
+	VersionTprv uint32 = hdversion.Tprv
+	VersionYpub uint32 = 0x049d7cb2
+	VersionYprv uint32 = 0x049d7878
+	VersionZpub uint32 = 0x04b24746
+	VersionZprv uint32 = 0x04b2430c
+	VersionUpub uint32 = 0x044a5262
+	VersionUprv uint32 = 0x044a4e28
+	VersionVpub uint32 = 0x045f1cf6
+	VersionVprv uint32 = 0x045f18bc
+)
+
+// Script types implied by SLIP-132 versions
+const (
+	ScriptP2PKH      = "p2pkh"
+	ScriptP2SHP2WPKH = "p2sh-p2wpkh"
+	ScriptP2WPKH     = "p2wpkh"
+)
+
+// VersionInfo describes what a version prefix identifies
+type VersionInfo struct {
+	// Prefix is the human-readable prefix of the encoding, such as "xpub"
+	Prefix string
+	// Network is the network the key belongs to
+	Network bitcoin.Network
+	// Private reports whether the key data holds a private key
+	Private bool
+	// Script is the output script type the key is used with
+	Script string
+}
+
+var versions = map[uint32]VersionInfo{
+	VersionXpub: {"xpub", bitcoin.Mainnet, false, ScriptP2PKH},
+	VersionXprv: {"xprv", bitcoin.Mainnet, true, ScriptP2PKH},
+	VersionTpub: {"tpub", bitcoin.Testnet, false, ScriptP2PKH},
+	VersionTprv: {"tprv", bitcoin.Testnet, true, ScriptP2PKH},
+	VersionYpub: {"ypub", bitcoin.Mainnet, false, ScriptP2SHP2WPKH},
+	VersionYprv: {"yprv", bitcoin.Mainnet, true, ScriptP2SHP2WPKH},
+	VersionZpub: {"zpub", bitcoin.Mainnet, false, ScriptP2WPKH},
+	VersionZprv: {"zprv", bitcoin.Mainnet, true, ScriptP2WPKH},
+	VersionUpub: {"upub", bitcoin.Testnet, false, ScriptP2SHP2WPKH},
+	VersionUprv: {"uprv", bitcoin.Testnet, true, ScriptP2SHP2WPKH},
+	VersionVpub: {"vpub", bitcoin.Testnet, false, ScriptP2WPKH},
+	VersionVprv: {"vprv", bitcoin.Testnet, true, ScriptP2WPKH},
+}
+
+// LookupVersion returns the description of a known version
+func LookupVersion(version uint32) (VersionInfo, bool) {
+	info, ok := versions[version]
+	return info, ok
+}
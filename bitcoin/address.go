@@ -0,0 +1,171 @@
+// Package bitcoin parses and generates legacy Base58Check Bitcoin addresses.
+package bitcoin
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/jnst/base58"
+	"github.com/jnst/base58/internal/ripemd160"
+)
+
+// HashSize is the length of a hash160 digest in bytes
+const HashSize = ripemd160.Size
+
+// AddressType distinguishes pay-to-pubkey-hash from pay-to-script-hash addresses
+type AddressType int
+
+const (
+	// P2PKH is a pay-to-pubkey-hash address
+	P2PKH AddressType = iota
+	// P2SH is a pay-to-script-hash address
+	P2SH
+)
+
+// String returns the address type name
+func (t AddressType) String() string {
+	switch t {
+	case P2PKH:
+		return "p2pkh"
+	case P2SH:
+		return "p2sh"
+	default:
+		return "unknown"
+	}
+}
+
+// Address is a legacy P2PKH or P2SH Bitcoin address
+type Address struct {
+	typ     AddressType
+	network Network
+	hash    [HashSize]byte
+}
+
+// Hash160 returns RIPEMD-160(SHA-256(data))
+func Hash160(data []byte) [HashSize]byte {
+	sum := sha256.Sum256(data)
+	return ripemd160.Sum(sum[:])
+}
+
+// NewAddressPubKeyHash returns the P2PKH address for a 20-byte hash160
+func NewAddressPubKeyHash(hash []byte, net Network) (Address, error) {
+	return newAddress(P2PKH, hash, net)
+}
+
+// NewAddressScriptHash returns the P2SH address for a 20-byte script hash160
+func NewAddressScriptHash(hash []byte, net Network) (Address, error) {
+	return newAddress(P2SH, hash, net)
+}
+
+// NewAddressPubKey returns the P2PKH address for a SEC-encoded public key,
+// either 33 bytes compressed or 65 bytes uncompressed
+func NewAddressPubKey(pubKey []byte, net Network) (Address, error) {
+	if !validPubKey(pubKey) {
+		return Address{}, ErrInvalidPublicKey
+	}
+	hash := Hash160(pubKey)
+	return newAddress(P2PKH, hash[:], net)
+}
+
+func newAddress(typ AddressType, hash []byte, net Network) (Address, error) {
+	if _, ok := networkParams[net]; !ok {
+		return Address{}, ErrUnknownNetwork
+	}
+	if len(hash) != HashSize {
+		return Address{}, ErrInvalidHashLength
+	}
+
+	addr := Address{typ: typ, network: net}
+	copy(addr.hash[:], hash)
+	return addr, nil
+}
+
+// validPubKey checks the SEC prefix and length of a public key
+func validPubKey(pubKey []byte) bool {
+	switch len(pubKey) {
+	case 33:
+		return pubKey[0] == 0x02 || pubKey[0] == 0x03
+	case 65:
+		return pubKey[0] == 0x04
+	default:
+		return false
+	}
+}
+
+// ParseAddress decodes a P2PKH or P2SH address. Testnet and regtest share
+// version bytes, so addresses for either report Testnet; use IsForNetwork
+// to check against a specific network.
+func ParseAddress(s string) (Address, error) {
+	version, payload, err := base58.CheckDecode(s)
+	if err != nil {
+		return Address{}, fmt.Errorf("bitcoin: %w", err)
+	}
+	if len(payload) != HashSize {
+		return Address{}, ErrInvalidHashLength
+	}
+
+	for _, net := range []Network{Mainnet, Testnet} {
+		p := networkParams[net]
+		switch version[0] {
+		case p.pubKeyHash:
+			return newAddress(P2PKH, payload, net)
+		case p.scriptHash:
+			return newAddress(P2SH, payload, net)
+		}
+	}
+	return Address{}, &VersionError{Version: version[0]}
+}
+
+// ParseAddressForNetwork decodes an address and checks that it belongs to net
+func ParseAddressForNetwork(s string, net Network) (Address, error) {
+	addr, err := ParseAddress(s)
+	if err != nil {
+		return Address{}, err
+	}
+	if !addr.IsForNetwork(net) {
+		return Address{}, ErrWrongNetwork
+	}
+	addr.network = net
+	return addr, nil
+}
+
+// Type returns whether the address is P2PKH or P2SH
+func (a Address) Type() AddressType {
+	return a.typ
+}
+
+// Network returns the network the address belongs to
+func (a Address) Network() Network {
+	return a.network
+}
+
+// Hash160 returns the 20-byte public key or script hash
+func (a Address) Hash160() [HashSize]byte {
+	return a.hash
+}
+
+// IsForNetwork reports whether the address is valid on net
+func (a Address) IsForNetwork(net Network) bool {
+	if _, ok := networkParams[net]; !ok {
+		return false
+	}
+	return a.version() == net.version(a.typ)
+}
+
+// String returns the Base58Check encoding of the address
+func (a Address) String() string {
+	return base58.CheckEncode([]byte{a.version()}, a.hash[:])
+}
+
+func (a Address) version() byte {
+	return a.network.version(a.typ)
+}
+
+// version returns the version byte for an address type on the network
+func (n Network) version(typ AddressType) byte {
+	p := networkParams[n]
+	if typ == P2SH {
+		return p.scriptHash
+	}
+	return p.pubKeyHash
+}
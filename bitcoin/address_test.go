@@ -0,0 +1,157 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/jnst/base58"
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		typ     AddressType
+		network Network
+		hash    string
+	}{
+		{"mainnet p2pkh", "1MirQ9bwyQcGVJPwKUgapu5ouK2E2Ey4gX", P2PKH, Mainnet, "e34cce70c86373273efcc54ce7d2a491bb4a0e84"},
+		{"mainnet p2sh", "3QJmV3qfvL9SuYo34YihAf3sRCW3qSinyC", P2SH, Mainnet, "f815b036d9bbbce5e9f2a00abd1bf3dc91e95510"},
+		{"testnet p2pkh", "mrX9vMRYLfVy1BnZbc5gZjuyaqH3ZW2ZHz", P2PKH, Testnet, "78b316a08647d5b77283e512d3603f1f1c8de68f"},
+		{"testnet p2sh", "2NBFNJTktNa7GZusGbDbGKRZTxdK9VVez3n", P2SH, Testnet, "c579342c2c4c9220205e2cdc285617040c924a0a"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := ParseAddress(tt.address)
+			if err != nil {
+				t.Fatalf("ParseAddress(%q) error = %v", tt.address, err)
+			}
+			if addr.Type() != tt.typ {
+				t.Errorf("Type() = %v, want %v", addr.Type(), tt.typ)
+			}
+			if addr.Network() != tt.network {
+				t.Errorf("Network() = %v, want %v", addr.Network(), tt.network)
+			}
+			if hash := addr.Hash160(); hex.EncodeToString(hash[:]) != tt.hash {
+				t.Errorf("Hash160() = %x, want %s", hash, tt.hash)
+			}
+			if addr.String() != tt.address {
+				t.Errorf("String() = %q, want %q", addr.String(), tt.address)
+			}
+		})
+	}
+}
+
+func TestParseAddressErrors(t *testing.T) {
+	hash := make([]byte, HashSize)
+	litecoin := base58.CheckEncode([]byte{0x30}, hash)
+	short := base58.CheckEncode([]byte{0x00}, hash[:19])
+
+	tests := []struct {
+		name    string
+		address string
+		err     error
+	}{
+		{"bad checksum", "1MirQ9bwyQcGVJPwKUgapu5ouK2E2Ey4gY", base58.ErrChecksum},
+		{"invalid character", "1MirQ9bwyQcGVJPwKUgapu5ouK2E2Ey40", base58.ErrInvalidCharacter},
+		{"unknown version", litecoin, ErrUnknownVersion},
+		{"short hash", short, ErrInvalidHashLength},
+		{"too short", "1", base58.ErrTooShort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseAddress(tt.address)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("ParseAddress(%q) error = %v, want %v", tt.address, err, tt.err)
+			}
+		})
+	}
+
+	var versionErr *VersionError
+	if _, err := ParseAddress(litecoin); !errors.As(err, &versionErr) || versionErr.Version != 0x30 {
+		t.Errorf("ParseAddress(%q) error = %v, want *VersionError for 0x30", litecoin, err)
+	}
+}
+
+func TestParseAddressForNetwork(t *testing.T) {
+	const testnet = "mrX9vMRYLfVy1BnZbc5gZjuyaqH3ZW2ZHz"
+
+	addr, err := ParseAddressForNetwork(testnet, Regtest)
+	if err != nil {
+		t.Fatalf("ParseAddressForNetwork(Regtest) error = %v", err)
+	}
+	if addr.Network() != Regtest {
+		t.Errorf("Network() = %v, want %v", addr.Network(), Regtest)
+	}
+
+	if _, err := ParseAddressForNetwork(testnet, Mainnet); !errors.Is(err, ErrWrongNetwork) {
+		t.Errorf("ParseAddressForNetwork(Mainnet) error = %v, want %v", err, ErrWrongNetwork)
+	}
+}
+
+func TestNewAddressPubKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		pubKey   string
+		expected string
+	}{
+		{
+			name:     "compressed",
+			pubKey:   "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+			expected: "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+		},
+		{
+			name: "uncompressed",
+			pubKey: "0479be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798" +
+				"483ada7726a3c4655da4fbfc0e1108a8fd17b448a68554199c47d08ffb10d4b8",
+			expected: "1EHNa6Q4Jz2uvNExL497mE43ikXhwF6kZm",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr, err := NewAddressPubKey(mustHex(t, tt.pubKey), Mainnet)
+			if err != nil {
+				t.Fatalf("NewAddressPubKey() error = %v", err)
+			}
+			if addr.String() != tt.expected {
+				t.Errorf("String() = %q, want %q", addr.String(), tt.expected)
+			}
+		})
+	}
+
+	if _, err := NewAddressPubKey(mustHex(t, "05"+hex.EncodeToString(make([]byte, 32))), Mainnet); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Errorf("NewAddressPubKey(bad prefix) error = %v, want %v", err, ErrInvalidPublicKey)
+	}
+}
+
+func TestNewAddressHash(t *testing.T) {
+	hash := mustHex(t, "c579342c2c4c9220205e2cdc285617040c924a0a")
+
+	addr, err := NewAddressScriptHash(hash, Testnet)
+	if err != nil {
+		t.Fatalf("NewAddressScriptHash() error = %v", err)
+	}
+	if addr.String() != "2NBFNJTktNa7GZusGbDbGKRZTxdK9VVez3n" {
+		t.Errorf("String() = %q", addr.String())
+	}
+
+	if _, err := NewAddressPubKeyHash(hash[:19], Mainnet); !errors.Is(err, ErrInvalidHashLength) {
+		t.Errorf("NewAddressPubKeyHash(short) error = %v, want %v", err, ErrInvalidHashLength)
+	}
+	if _, err := NewAddressPubKeyHash(hash, Network(99)); !errors.Is(err, ErrUnknownNetwork) {
+		t.Errorf("NewAddressPubKeyHash(unknown network) error = %v, want %v", err, ErrUnknownNetwork)
+	}
+}
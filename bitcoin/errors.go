@@ -0,0 +1,41 @@
+package bitcoin
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrUnknownVersion is returned when a version byte does not belong to
+	// any supported network
+	ErrUnknownVersion = errors.New("bitcoin: unknown version byte")
+	// ErrWrongNetwork is returned when an address belongs to a different
+	// network than requested
+	ErrWrongNetwork = errors.New("bitcoin: address is for a different network")
+	// ErrInvalidHashLength is returned when a hash160 is not 20 bytes
+	ErrInvalidHashLength = errors.New("bitcoin: hash160 must be 20 bytes")
+	// ErrInvalidPublicKey is returned when a public key is not a valid
+	// compressed or uncompressed SEC encoding
+	ErrInvalidPublicKey = errors.New("bitcoin: invalid public key")
+	// ErrInvalidKeyLength is returned when a private key is not 32 bytes
+	ErrInvalidKeyLength = errors.New("bitcoin: private key must be 32 bytes")
+	// ErrInvalidCompressionFlag is returned when the byte following a WIF
+	// private key is not the 0x01 compression flag
+	ErrInvalidCompressionFlag = errors.New("bitcoin: invalid WIF compression flag")
+	// ErrUnknownNetwork is returned when a Network value is not supported
+	ErrUnknownNetwork = errors.New("bitcoin: unknown network")
+)
+
+// VersionError reports a version byte that is not recognized
+type VersionError struct {
+	Version byte
+}
+
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("bitcoin: unknown version byte 0x%02x", e.Version)
+}
+
+// Is reports whether target is ErrUnknownVersion
+func (e *VersionError) Is(target error) bool {
+	return target == ErrUnknownVersion
+}
@@ -0,0 +1,77 @@
+package bitcoin
+
+import (
+	"fmt"
+
+	"github.com/jnst/base58/network"
+)
+
+// Network identifies a Bitcoin network
+type Network int
+
+const (
+	// Mainnet is the Bitcoin main network
+	Mainnet Network = iota
+	// Testnet is the Bitcoin test network
+	Testnet
+	// Regtest is the local regression test network
+	Regtest
+)
+
+// params holds the version bytes used by a network
+type params struct {
+	pubKeyHash byte
+	scriptHash byte
+	privateKey byte
+}
+
+// registryNames maps each network to its entry in the network registry,
+// which is the single source of the version bytes
+var registryNames = map[Network]string{
+	Mainnet: "bitcoin",
+	Testnet: "bitcoin-testnet",
+	Regtest: "bitcoin-regtest",
+}
+
+var networkParams = loadParams()
+
+// loadParams reads the version bytes of every network from the registry
+func loadParams() map[Network]params {
+	m := make(map[Network]params, len(registryNames))
+	for net, name := range registryNames {
+		p, ok := network.Lookup(name)
+		if !ok {
+			panic("bitcoin: network " + name + " is not registered")
+		}
+		m[net] = params{
+			pubKeyHash: p.PubKeyHash[0],
+			scriptHash: p.ScriptHash[0],
+			privateKey: p.PrivateKey[0],
+		}
+	}
+	return m
+}
+
+// ParseNetwork returns the network with the given name
+func ParseNetwork(name string) (Network, error) {
+	for _, net := range []Network{Mainnet, Testnet, Regtest} {
+		if net.String() == name {
+			return net, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %q", ErrUnknownNetwork, name)
+}
+
+// String returns the network name
+func (n Network) String() string {
+	switch n {
+	case Mainnet:
+		return "mainnet"
+	case Testnet:
+		return "testnet"
+	case Regtest:
+		return "regtest"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,84 @@
+package bitcoin
+
+import (
+	"fmt"
+
+	"github.com/jnst/base58"
+)
+
+// PrivateKeySize is the length of a secp256k1 private key in bytes
+const PrivateKeySize = 32
+
+// compressionFlag marks a WIF key whose public key is compressed
+const compressionFlag = 0x01
+
+// WIF is a private key in Wallet Import Format
+type WIF struct {
+	// PrivateKey is the 32-byte secret
+	PrivateKey [PrivateKeySize]byte
+	// Network is the network the key is for. Testnet and regtest share a
+	// version byte, so decoded keys for either report Testnet.
+	Network Network
+	// Compressed reports whether the key derives a compressed public key
+	Compressed bool
+}
+
+// EncodeWIF encodes a 32-byte private key in Wallet Import Format
+func EncodeWIF(privateKey []byte, net Network, compressed bool) (string, error) {
+	p, ok := networkParams[net]
+	if !ok {
+		return "", ErrUnknownNetwork
+	}
+	if len(privateKey) != PrivateKeySize {
+		return "", ErrInvalidKeyLength
+	}
+
+	payload := privateKey
+	if compressed {
+		payload = make([]byte, 0, PrivateKeySize+1)
+		payload = append(payload, privateKey...)
+		payload = append(payload, compressionFlag)
+	}
+	return base58.CheckEncode([]byte{p.privateKey}, payload), nil
+}
+
+// DecodeWIF decodes a Wallet Import Format private key
+func DecodeWIF(s string) (WIF, error) {
+	version, payload, err := base58.CheckDecode(s)
+	if err != nil {
+		return WIF{}, fmt.Errorf("bitcoin: %w", err)
+	}
+
+	var w WIF
+	switch version[0] {
+	case networkParams[Mainnet].privateKey:
+		w.Network = Mainnet
+	case networkParams[Testnet].privateKey:
+		w.Network = Testnet
+	default:
+		return WIF{}, &VersionError{Version: version[0]}
+	}
+
+	switch len(payload) {
+	case PrivateKeySize:
+	case PrivateKeySize + 1:
+		if payload[PrivateKeySize] != compressionFlag {
+			return WIF{}, ErrInvalidCompressionFlag
+		}
+		w.Compressed = true
+	default:
+		return WIF{}, ErrInvalidKeyLength
+	}
+
+	copy(w.PrivateKey[:], payload)
+	return w, nil
+}
+
+// String returns the Wallet Import Format encoding of the key
+func (w WIF) String() string {
+	s, err := EncodeWIF(w.PrivateKey[:], w.Network, w.Compressed)
+	if err != nil {
+		return ""
+	}
+	return s
+}
@@ -0,0 +1,82 @@
+package bitcoin
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jnst/base58"
+)
+
+func TestWIF(t *testing.T) {
+	keyOne := make([]byte, PrivateKeySize)
+	keyOne[PrivateKeySize-1] = 1
+
+	tests := []struct {
+		name       string
+		network    Network
+		compressed bool
+		expected   string
+	}{
+		{"mainnet compressed", Mainnet, true, "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn"},
+		{"mainnet uncompressed", Mainnet, false, "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDf"},
+		{"testnet compressed", Testnet, true, "cMahea7zqjxrtgAbB7LSGbcQUr1uX1ojuat9jZodMN87JcbXMTcA"},
+		{"testnet uncompressed", Testnet, false, "91avARGdfge8E4tZfYLoxeJ5sGBdNJQH4kvjJoQFacbgwmaKkrx"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := EncodeWIF(keyOne, tt.network, tt.compressed)
+			if err != nil {
+				t.Fatalf("EncodeWIF() error = %v", err)
+			}
+			if encoded != tt.expected {
+				t.Errorf("EncodeWIF() = %q, want %q", encoded, tt.expected)
+			}
+
+			w, err := DecodeWIF(tt.expected)
+			if err != nil {
+				t.Fatalf("DecodeWIF() error = %v", err)
+			}
+			if string(w.PrivateKey[:]) != string(keyOne) {
+				t.Errorf("PrivateKey = %x, want %x", w.PrivateKey, keyOne)
+			}
+			if w.Network != tt.network || w.Compressed != tt.compressed {
+				t.Errorf("DecodeWIF() = %v/%v, want %v/%v", w.Network, w.Compressed, tt.network, tt.compressed)
+			}
+			if w.String() != tt.expected {
+				t.Errorf("String() = %q, want %q", w.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestWIFErrors(t *testing.T) {
+	key := make([]byte, PrivateKeySize)
+
+	badFlag := base58.CheckEncode([]byte{0x80}, append(append([]byte{}, key...), 0x02))
+	short := base58.CheckEncode([]byte{0x80}, key[:31])
+	address := base58.CheckEncode([]byte{0x00}, key)
+
+	tests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{"bad checksum", "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWo", base58.ErrChecksum},
+		{"bad compression flag", badFlag, ErrInvalidCompressionFlag},
+		{"short key", short, ErrInvalidKeyLength},
+		{"address version", address, ErrUnknownVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DecodeWIF(tt.input); !errors.Is(err, tt.err) {
+				t.Errorf("DecodeWIF(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+		})
+	}
+
+	if _, err := EncodeWIF(key[:31], Mainnet, true); !errors.Is(err, ErrInvalidKeyLength) {
+		t.Errorf("EncodeWIF(short) error = %v, want %v", err, ErrInvalidKeyLength)
+	}
+}
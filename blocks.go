@@ -0,0 +1,101 @@
+package base58
+
+// EncodeBlocks encodes src with the block framing used by Monero and by
+// NewEncoder, using the Bitcoin alphabet. The result is not compatible with
+// Encode.
+func EncodeBlocks(src []byte) string {
+	return BitcoinEncoding.EncodeBlocks(src)
+}
+
+// DecodeBlocks decodes block-framed input produced by EncodeBlocks
+func DecodeBlocks(s string) ([]byte, error) {
+	return BitcoinEncoding.DecodeBlocks(s)
+}
+
+// CheckEncodeBlocksWith encodes version and payload followed by the checksum
+// c computes over both, with block framing and the Bitcoin alphabet
+func CheckEncodeBlocksWith(c Checksummer, version, payload []byte) string {
+	return BitcoinEncoding.CheckEncodeBlocksWith(c, version, payload)
+}
+
+// CheckDecodeBlocksWith decodes block-framed input with the Bitcoin
+// alphabet, verifies checksum c and splits the result into a version of
+// versionLen bytes and the remaining payload
+func CheckDecodeBlocksWith(c Checksummer, s string, versionLen int) (version, payload []byte, err error) {
+	return BitcoinEncoding.CheckDecodeBlocksWith(c, s, versionLen)
+}
+
+// EncodedBlocksLen returns the length of the block-framed encoding of n bytes
+func EncodedBlocksLen(n int) int {
+	return n/blockSize*encodedBlockSize + encodedBlockSizes[n%blockSize]
+}
+
+// EncodeBlocks encodes every 8 bytes of src as an 11-character group, with
+// a shorter group for a trailing partial block
+func (enc *Encoding) EncodeBlocks(src []byte) string {
+	dst := make([]byte, EncodedBlocksLen(len(src)))
+
+	out := dst
+	for len(src) > 0 {
+		n := blockSize
+		if len(src) < n {
+			n = len(src)
+		}
+		size := encodedBlockSizes[n]
+		enc.encodeBlock(out[:size], src[:n])
+		out = out[size:]
+		src = src[n:]
+	}
+	return string(dst)
+}
+
+// DecodeBlocks decodes block-framed input. It returns ErrInvalidBlock if the
+// final group has an impossible length or a group overflows its block.
+func (enc *Encoding) DecodeBlocks(s string) ([]byte, error) {
+	// Validate up front so errors report offsets in s
+	if err := enc.Validate(s); err != nil {
+		return nil, err
+	}
+
+	tail := decodedBlockSize(len(s) % encodedBlockSize)
+	if tail < 0 {
+		return nil, ErrInvalidBlock
+	}
+
+	full := len(s) / encodedBlockSize
+	dst := make([]byte, full*blockSize+tail)
+	out := dst
+	var block [encodedBlockSize]byte
+	for len(s) > 0 {
+		size := encodedBlockSize
+		if len(s) < size {
+			size = len(s)
+		}
+		n := decodedBlockSize(size)
+		copy(block[:], s[:size])
+		if err := enc.decodeBlock(out[:n], block[:size]); err != nil {
+			return nil, err
+		}
+		out = out[n:]
+		s = s[size:]
+	}
+	return dst, nil
+}
+
+// CheckEncodeBlocksWith is like CheckEncodeWith but uses block framing, as
+// Monero addresses do
+func (enc *Encoding) CheckEncodeBlocksWith(c Checksummer, version, payload []byte) string {
+	return enc.EncodeBlocks(appendChecked(c, version, payload))
+}
+
+// CheckDecodeBlocksWith is like CheckDecodeWith but decodes block-framed input
+func (enc *Encoding) CheckDecodeBlocksWith(c Checksummer, s string, versionLen int) (version, payload []byte, err error) {
+	if versionLen < 0 {
+		return nil, nil, ErrInvalidVersionLength
+	}
+	decoded, err := enc.DecodeBlocks(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	return splitChecked(c, decoded, versionLen)
+}
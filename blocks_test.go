@@ -0,0 +1,128 @@
+package base58
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestEncodeBlocks(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected string
+	}{
+		{"empty", []byte{}, ""},
+		{"one zero byte", []byte{0x00}, "11"},
+		{"one byte", []byte{0xff}, "5Q"},
+		{"full zero block", make([]byte, 8), "11111111111"},
+		{"full block", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "jpXCZedGfVQ"},
+		{"block and partial", []byte{0x06, 0x15, 0x60, 0x13, 0x76, 0x28, 0x79, 0xf7, 0x22}, "222222222221b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := EncodeBlocks(tt.input)
+			if encoded != tt.expected {
+				t.Errorf("EncodeBlocks(%x) = %q, want %q", tt.input, encoded, tt.expected)
+			}
+			if len(encoded) != EncodedBlocksLen(len(tt.input)) {
+				t.Errorf("EncodedBlocksLen(%d) = %d, want %d", len(tt.input), EncodedBlocksLen(len(tt.input)), len(encoded))
+			}
+
+			decoded, err := DecodeBlocks(encoded)
+			if err != nil {
+				t.Fatalf("DecodeBlocks(%q) error = %v", encoded, err)
+			}
+			if !bytes.Equal(decoded, tt.input) {
+				t.Errorf("DecodeBlocks(%q) = %x, want %x", encoded, decoded, tt.input)
+			}
+		})
+	}
+}
+
+func TestEncodeBlocksMatchesStream(t *testing.T) {
+	for size := 0; size < 40; size++ {
+		data := generateRandomBytes(size)
+
+		var buf strings.Builder
+		w := NewEncoder(&buf)
+		w.Write(data)
+		w.Close()
+
+		if got := EncodeBlocks(data); got != buf.String() {
+			t.Errorf("EncodeBlocks(%d bytes) = %q, stream = %q", size, got, buf.String())
+		}
+
+		streamed, err := io.ReadAll(NewDecoder(strings.NewReader(buf.String())))
+		if err != nil || !bytes.Equal(streamed, data) {
+			t.Errorf("NewDecoder(%q) = %x, %v, want %x", buf.String(), streamed, err, data)
+		}
+	}
+}
+
+func TestDecodeBlocksErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		err    error
+		offset int
+	}{
+		{"impossible tail length", "1", ErrInvalidBlock, -1},
+		{"impossible tail after block", "111111111111111", ErrInvalidBlock, -1},
+		{"block overflow", "zzzzzzzzzzz", ErrInvalidBlock, -1},
+		{"partial block overflow", "zz", ErrInvalidBlock, -1},
+		{"invalid character in second block", "1111111111111O", ErrInvalidCharacter, 13},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeBlocks(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("DecodeBlocks(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+			var corrupt *CorruptInputError
+			if tt.offset >= 0 && (!errors.As(err, &corrupt) || corrupt.Offset != tt.offset) {
+				t.Errorf("DecodeBlocks(%q) error = %v, want offset %d", tt.input, err, tt.offset)
+			}
+		})
+	}
+}
+
+func TestCheckBlocksWith(t *testing.T) {
+	// The Monero donation address: a varint network prefix, spend and view
+	// keys, and a Keccak-256 checksum, all block-framed
+	const donation = "44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A"
+
+	version, payload, err := CheckDecodeBlocksWith(Keccak256, donation, 1)
+	if err != nil {
+		t.Fatalf("CheckDecodeBlocksWith(%q) unexpected error: %v", donation, err)
+	}
+	if !bytes.Equal(version, []byte{0x12}) || len(payload) != 64 {
+		t.Errorf("CheckDecodeBlocksWith(%q) = %x, %d bytes, want 12, 64 bytes", donation, version, len(payload))
+	}
+	if got := CheckEncodeBlocksWith(Keccak256, version, payload); got != donation {
+		t.Errorf("CheckEncodeBlocksWith() = %q, want %q", got, donation)
+	}
+
+	tests := []struct {
+		name       string
+		c          Checksummer
+		input      string
+		versionLen int
+		err        error
+	}{
+		{"wrong checksum", DoubleSHA256, donation, 1, ErrChecksum},
+		{"too short", Keccak256, EncodeBlocks([]byte{1, 2, 3}), 0, ErrTooShort},
+		{"negative version length", Keccak256, donation, -1, ErrInvalidVersionLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := CheckDecodeBlocksWith(tt.c, tt.input, tt.versionLen); !errors.Is(err, tt.err) {
+				t.Errorf("CheckDecodeBlocksWith(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+		})
+	}
+}
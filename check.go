@@ -0,0 +1,196 @@
+package base58
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/jnst/base58/internal/blake256"
+	"github.com/jnst/base58/internal/blake2b"
+	"github.com/jnst/base58/internal/keccak"
+	"github.com/jnst/base58/internal/ripemd160"
+)
+
+// checksumLen is the number of checksum bytes appended by Base58Check and CB58
+const checksumLen = 4
+
+// ss58AccountChecksumLen is the SS58 checksum length for 32-byte account payloads
+const ss58AccountChecksumLen = 2
+
+// ss58Preimage is prepended to the data hashed for the SS58 checksum
+const ss58Preimage = "SS58PRE"
+
+// Checksummer computes the checksum appended to payloads by the checked
+// encodings. Implementations must return exactly Size bytes.
+type Checksummer interface {
+	// Size returns the checksum length in bytes
+	Size() int
+	// Checksum returns the checksum of data
+	Checksum(data []byte) []byte
+}
+
+// checksumFunc adapts a function to the Checksummer interface
+type checksumFunc struct {
+	size int
+	sum  func(data []byte) []byte
+}
+
+func (c checksumFunc) Size() int { return c.size }
+
+func (c checksumFunc) Checksum(data []byte) []byte { return c.sum(data)[:c.size] }
+
+// NewChecksummer returns a Checksummer that keeps the first size bytes of
+// sum(data). It panics if size is not between 1 and the length of sum's
+// output, which it learns by calling sum once on empty input.
+func NewChecksummer(size int, sum func(data []byte) []byte) Checksummer {
+	return newChecksummer(size, len(sum(nil)), sum)
+}
+
+// newChecksummer is NewChecksummer for a sum whose output length is known
+func newChecksummer(size, sumLen int, sum func(data []byte) []byte) Checksummer {
+	if size < 1 || size > sumLen {
+		panic(fmt.Sprintf("base58: checksum size %d out of range 1..%d", size, sumLen))
+	}
+	return checksumFunc{size: size, sum: sum}
+}
+
+// NewSS58Checksummer returns the SS58 checksum of the given length: the
+// first size bytes of BLAKE2b-512("SS58PRE" || data). SS58 uses one byte
+// for account indices and two bytes for public keys. It panics if size is
+// not between 1 and 64.
+func NewSS58Checksummer(size int) Checksummer {
+	return newChecksummer(size, blake2b.Size, func(data []byte) []byte {
+		buf := make([]byte, 0, len(ss58Preimage)+len(data))
+		buf = append(buf, ss58Preimage...)
+		buf = append(buf, data...)
+		sum := blake2b.Sum512(buf)
+		return sum[:]
+	})
+}
+
+// Built-in checksums
+var (
+	// DoubleSHA256 is the Base58Check checksum: the first four bytes of
+	// SHA-256(SHA-256(data))
+	DoubleSHA256 = NewChecksummer(checksumLen, func(data []byte) []byte {
+		first := sha256.Sum256(data)
+		second := sha256.Sum256(first[:])
+		return second[:]
+	})
+	// SHA256Tail is the Avalanche CB58 checksum: the last four bytes of a
+	// single SHA-256(data)
+	SHA256Tail = NewChecksummer(checksumLen, func(data []byte) []byte {
+		sum := sha256.Sum256(data)
+		return sum[sha256.Size-checksumLen:]
+	})
+	// RIPEMD160 is the EOS public key checksum: the first four bytes of
+	// RIPEMD-160(data)
+	RIPEMD160 = NewChecksummer(checksumLen, func(data []byte) []byte {
+		sum := ripemd160.Sum(data)
+		return sum[:]
+	})
+	// DoubleBLAKE256 is the Decred checksum: the first four bytes of
+	// BLAKE-256(BLAKE-256(data))
+	DoubleBLAKE256 = NewChecksummer(checksumLen, func(data []byte) []byte {
+		first := blake256.Sum256(data)
+		second := blake256.Sum256(first[:])
+		return second[:]
+	})
+	// SS58Blake2b is the SS58 checksum for 32-byte account payloads: the
+	// first two bytes of BLAKE2b-512("SS58PRE" || data)
+	SS58Blake2b = NewSS58Checksummer(ss58AccountChecksumLen)
+	// Keccak256 is the Monero checksum: the first four bytes of
+	// Keccak-256(data). Monero addresses are block-framed, so use it with
+	// CheckEncodeBlocksWith and CheckDecodeBlocksWith.
+	Keccak256 = NewChecksummer(checksumLen, func(data []byte) []byte {
+		sum := keccak.Sum256(data)
+		return sum[:]
+	})
+)
+
+// CheckEncode encodes version and payload as Base58Check with the Bitcoin alphabet
+func CheckEncode(version, payload []byte) string {
+	return BitcoinEncoding.CheckEncode(version, payload)
+}
+
+// CheckDecode decodes a Base58Check string with a single version byte
+// using the Bitcoin alphabet
+func CheckDecode(s string) (version, payload []byte, err error) {
+	return BitcoinEncoding.CheckDecode(s, 1)
+}
+
+// CheckEncodeWith encodes version and payload with checksum c and the Bitcoin alphabet
+func CheckEncodeWith(c Checksummer, version, payload []byte) string {
+	return BitcoinEncoding.CheckEncodeWith(c, version, payload)
+}
+
+// CheckDecodeWith decodes s with the Bitcoin alphabet, verifies checksum c and
+// splits the result into a version of versionLen bytes and the remaining payload
+func CheckDecodeWith(c Checksummer, s string, versionLen int) (version, payload []byte, err error) {
+	return BitcoinEncoding.CheckDecodeWith(c, s, versionLen)
+}
+
+// CB58Encode encodes data with the Avalanche CB58 checksum and the Bitcoin alphabet
+func CB58Encode(data []byte) string {
+	return BitcoinEncoding.CheckEncodeWith(SHA256Tail, nil, data)
+}
+
+// CB58Decode decodes a CB58 string and verifies its checksum
+func CB58Decode(s string) ([]byte, error) {
+	_, payload, err := BitcoinEncoding.CheckDecodeWith(SHA256Tail, s, 0)
+	return payload, err
+}
+
+// CheckEncode encodes version and payload followed by a 4-byte double SHA-256 checksum
+func (enc *Encoding) CheckEncode(version, payload []byte) string {
+	return enc.CheckEncodeWith(DoubleSHA256, version, payload)
+}
+
+// CheckDecode decodes a Base58Check string, verifies its checksum and splits
+// the result into a version of versionLen bytes and the remaining payload
+func (enc *Encoding) CheckDecode(s string, versionLen int) (version, payload []byte, err error) {
+	return enc.CheckDecodeWith(DoubleSHA256, s, versionLen)
+}
+
+// CheckEncodeWith encodes version and payload followed by the checksum c
+// computes over both
+func (enc *Encoding) CheckEncodeWith(c Checksummer, version, payload []byte) string {
+	return enc.Encode(appendChecked(c, version, payload))
+}
+
+// CheckDecodeWith decodes s, verifies the trailing checksum with c and splits
+// the result into a version of versionLen bytes and the remaining payload
+func (enc *Encoding) CheckDecodeWith(c Checksummer, s string, versionLen int) (version, payload []byte, err error) {
+	if versionLen < 0 {
+		return nil, nil, ErrInvalidVersionLength
+	}
+	decoded, err := enc.Decode(s)
+	if err != nil {
+		return nil, nil, err
+	}
+	return splitChecked(c, decoded, versionLen)
+}
+
+// appendChecked returns version and payload followed by the checksum c
+// computes over both
+func appendChecked(c Checksummer, version, payload []byte) []byte {
+	data := make([]byte, 0, len(version)+len(payload)+c.Size())
+	data = append(data, version...)
+	data = append(data, payload...)
+	return append(data, c.Checksum(data)...)
+}
+
+// splitChecked verifies the trailing checksum of decoded with c and splits
+// the rest into a version of versionLen bytes and the payload
+func splitChecked(c Checksummer, decoded []byte, versionLen int) (version, payload []byte, err error) {
+	size := c.Size()
+	if len(decoded) < versionLen+size {
+		return nil, nil, ErrTooShort
+	}
+
+	data := decoded[:len(decoded)-size]
+	if string(c.Checksum(data)) != string(decoded[len(data):]) {
+		return nil, nil, ErrChecksum
+	}
+
+	return data[:versionLen], data[versionLen:], nil
+}
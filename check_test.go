@@ -0,0 +1,276 @@
+package base58
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestCheckEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  []byte
+		payload  string
+		expected string
+	}{
+		{
+			name:     "bitcoin p2pkh address",
+			version:  []byte{0x00},
+			payload:  "010966776006953d5567439e5e39f86a0d273bee",
+			expected: "16UwLL9Risc3QfPqBUvKofHmBQ7wMtjvM",
+		},
+		{
+			name:     "bitcoin p2sh address",
+			version:  []byte{0x05},
+			payload:  "74f209f6ea907e2ea48f74fae05782ae8a665257",
+			expected: "3CMNFxN1oHBc4R1EpboAL5yzHGgE611Xou",
+		},
+		{
+			name:     "empty payload",
+			version:  []byte{0x00},
+			payload:  "",
+			expected: "1Wh4bh",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, err := hex.DecodeString(tt.payload)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			encoded := CheckEncode(tt.version, payload)
+			if encoded != tt.expected {
+				t.Fatalf("CheckEncode() = %q, want %q", encoded, tt.expected)
+			}
+
+			version, decoded, err := CheckDecode(encoded)
+			if err != nil {
+				t.Fatalf("CheckDecode(%q) unexpected error: %v", encoded, err)
+			}
+			if !bytes.Equal(version, tt.version) {
+				t.Errorf("CheckDecode(%q) version = %x, want %x", encoded, version, tt.version)
+			}
+			if !bytes.Equal(decoded, payload) {
+				t.Errorf("CheckDecode(%q) payload = %x, want %x", encoded, decoded, payload)
+			}
+		})
+	}
+}
+
+func TestCheckDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{
+			name:  "bad checksum",
+			input: "16UwLL9Risc3QfPqBUvKofHmBQ7wMtjvN",
+			err:   ErrChecksum,
+		},
+		{
+			name:  "bad character",
+			input: "16UwLL9Risc3QfPqBUvKofHmBQ7wMtjv0",
+			err:   ErrInvalidCharacter,
+		},
+		{
+			name:  "too short",
+			input: "3QJmnh",
+			err:   ErrTooShort,
+		},
+		{
+			name:  "empty",
+			input: "",
+			err:   ErrTooShort,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := CheckDecode(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("CheckDecode(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestCheckDecodeVersionLength(t *testing.T) {
+	version := []byte{0x1C, 0xB8}
+	payload := bytes.Repeat([]byte{0xAB}, 20)
+
+	encoded := CheckEncode(version, payload)
+	gotVersion, gotPayload, err := BitcoinEncoding.CheckDecode(encoded, len(version))
+	if err != nil {
+		t.Fatalf("CheckDecode(%q) unexpected error: %v", encoded, err)
+	}
+	if !bytes.Equal(gotVersion, version) || !bytes.Equal(gotPayload, payload) {
+		t.Errorf("CheckDecode(%q) = %x, %x, want %x, %x", encoded, gotVersion, gotPayload, version, payload)
+	}
+
+	if _, _, err := BitcoinEncoding.CheckDecode(encoded, -1); !errors.Is(err, ErrInvalidVersionLength) {
+		t.Errorf("CheckDecode(%q, -1) error = %v, want %v", encoded, err, ErrInvalidVersionLength)
+	}
+}
+
+func TestCB58(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected string
+	}{
+		{"empty ID", make([]byte, 32), "11111111111111111111111111111111LpoYY"},
+		{"empty short ID", make([]byte, 20), "111111111111111111116DBWJs"},
+		{"text", []byte("Hello world"), "32UWxgjUJd9s6Kyvxjj1u"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded := CB58Encode(tt.input)
+			if encoded != tt.expected {
+				t.Errorf("CB58Encode(%x) = %q, want %q", tt.input, encoded, tt.expected)
+			}
+
+			decoded, err := CB58Decode(encoded)
+			if err != nil {
+				t.Fatalf("CB58Decode(%q) unexpected error: %v", encoded, err)
+			}
+			if !bytes.Equal(decoded, tt.input) {
+				t.Errorf("CB58Decode(%q) = %x, want %x", encoded, decoded, tt.input)
+			}
+		})
+	}
+
+	// A Base58Check string does not carry a valid CB58 checksum
+	if _, err := CB58Decode(CheckEncode(nil, []byte("Hello world"))); !errors.Is(err, ErrChecksum) {
+		t.Errorf("CB58Decode(Base58Check) error = %v, want %v", err, ErrChecksum)
+	}
+}
+
+func TestCheckEncodeWith(t *testing.T) {
+	// A custom two-byte checksum plugged into the checked encoding
+	sum := NewChecksummer(2, func(data []byte) []byte {
+		var x, y byte
+		for _, b := range data {
+			x ^= b
+			y += b
+		}
+		return []byte{x, y}
+	})
+
+	version := []byte{0x2A}
+	payload := []byte("payload")
+
+	encoded := RippleEncoding.CheckEncodeWith(sum, version, payload)
+	gotVersion, gotPayload, err := RippleEncoding.CheckDecodeWith(sum, encoded, 1)
+	if err != nil {
+		t.Fatalf("CheckDecodeWith(%q) unexpected error: %v", encoded, err)
+	}
+	if !bytes.Equal(gotVersion, version) || !bytes.Equal(gotPayload, payload) {
+		t.Errorf("CheckDecodeWith(%q) = %x, %x, want %x, %x", encoded, gotVersion, gotPayload, version, payload)
+	}
+
+	if _, _, err := RippleEncoding.CheckDecodeWith(DoubleSHA256, encoded, 1); !errors.Is(err, ErrChecksum) {
+		t.Errorf("CheckDecodeWith(DoubleSHA256) error = %v, want %v", err, ErrChecksum)
+	}
+	if _, _, err := RippleEncoding.CheckDecodeWith(sum, RippleEncoding.Encode([]byte{1, 2}), 1); !errors.Is(err, ErrTooShort) {
+		t.Errorf("CheckDecodeWith(short) error = %v, want %v", err, ErrTooShort)
+	}
+}
+
+func TestBuiltinChecksummers(t *testing.T) {
+	tests := []struct {
+		name       string
+		c          Checksummer
+		encoded    string
+		versionLen int
+		version    string
+		payload    string
+	}{
+		{
+			name:       "decred p2pkh address",
+			c:          DoubleBLAKE256,
+			encoded:    "DsUZxxoHJSty8DCfwfartwTYbuhmVct7tJu",
+			versionLen: 2,
+			version:    "073f",
+			payload:    "2789d58cfa0957d206f025c2af056fc8a77cebb0",
+		},
+		{
+			name:       "eos public key without its EOS prefix",
+			c:          RIPEMD160,
+			encoded:    "6MRyAjQq8ud7hVNYcfnVPJqcVpscN5So8BhtHuGYqET5GDW5CV",
+			versionLen: 0,
+			version:    "",
+			payload:    "02c0ded2bc1f1305fb0faac5e6c03ee3a1924234985427b6167ca569d13df435cf",
+		},
+		{
+			name:       "ss58 substrate address",
+			c:          SS58Blake2b,
+			encoded:    "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY",
+			versionLen: 1,
+			version:    "2a",
+			payload:    "d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, payload, err := CheckDecodeWith(tt.c, tt.encoded, tt.versionLen)
+			if err != nil {
+				t.Fatalf("CheckDecodeWith(%q) unexpected error: %v", tt.encoded, err)
+			}
+			if hex.EncodeToString(version) != tt.version || hex.EncodeToString(payload) != tt.payload {
+				t.Errorf("CheckDecodeWith(%q) = %x, %x, want %s, %s", tt.encoded, version, payload, tt.version, tt.payload)
+			}
+			if got := CheckEncodeWith(tt.c, version, payload); got != tt.encoded {
+				t.Errorf("CheckEncodeWith(%x, %x) = %q, want %q", version, payload, got, tt.encoded)
+			}
+			if _, _, err := CheckDecodeWith(DoubleSHA256, tt.encoded, tt.versionLen); !errors.Is(err, ErrChecksum) {
+				t.Errorf("CheckDecodeWith(DoubleSHA256, %q) error = %v, want %v", tt.encoded, err, ErrChecksum)
+			}
+		})
+	}
+
+	// Keccak-256("") starts with c5d24601
+	if got := hex.EncodeToString(Keccak256.Checksum(nil)); got != "c5d24601" {
+		t.Errorf("Keccak256.Checksum(nil) = %s, want c5d24601", got)
+	}
+}
+
+func TestChecksummerSize(t *testing.T) {
+	sha := func(data []byte) []byte {
+		sum := sha256.Sum256(data)
+		return sum[:]
+	}
+
+	tests := []struct {
+		name   string
+		new    func() Checksummer
+		panics bool
+	}{
+		{"whole digest", func() Checksummer { return NewChecksummer(sha256.Size, sha) }, false},
+		{"longer than digest", func() Checksummer { return NewChecksummer(40, sha) }, true},
+		{"zero", func() Checksummer { return NewChecksummer(0, sha) }, true},
+		{"negative", func() Checksummer { return NewChecksummer(-1, sha) }, true},
+		{"ss58 one byte", func() Checksummer { return NewSS58Checksummer(1) }, false},
+		{"ss58 longer than digest", func() Checksummer { return NewSS58Checksummer(65) }, true},
+		{"ss58 zero", func() Checksummer { return NewSS58Checksummer(0) }, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.panics {
+					t.Errorf("panic = %v, want panic %v", r, tt.panics)
+				}
+			}()
+			if c := tt.new(); len(c.Checksum([]byte("data"))) != c.Size() {
+				t.Errorf("Checksum length differs from Size %d", c.Size())
+			}
+		})
+	}
+}
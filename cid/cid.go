@@ -0,0 +1,89 @@
+// Package cid parses and computes IPFS CIDv0 identifiers, which are the
+// Base58 encoding of a sha2-256 multihash of a DAG-PB block.
+//
+// Sum and SumReader wrap file contents in UnixFS the way "ipfs add" does
+// with its default settings (256 KiB chunks, balanced layout, no raw
+// leaves), so they return the same CID.
+package cid
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/jnst/base58/multihash"
+)
+
+const (
+	// V0Len is the length of a CIDv0 string
+	V0Len = 46
+	// v0Prefix is the string prefix every CIDv0 starts with
+	v0Prefix = "Qm"
+)
+
+// ErrInvalidCID is returned when a string is not a sha2-256 CIDv0
+var ErrInvalidCID = errors.New("cid: not a CIDv0")
+
+// CID is a version 0 content identifier
+type CID struct {
+	multihash.Decoded
+}
+
+// Parse decodes a CIDv0 string into its multihash code, digest length and digest
+func Parse(s string) (CID, error) {
+	if len(s) != V0Len || s[:len(v0Prefix)] != v0Prefix {
+		return CID{}, fmt.Errorf("%w: must be %d characters starting with %q", ErrInvalidCID, V0Len, v0Prefix)
+	}
+
+	mh, err := multihash.FromB58String(s)
+	if err != nil {
+		return CID{}, fmt.Errorf("cid: %w", err)
+	}
+	decoded, err := multihash.Decode(mh)
+	if err != nil {
+		return CID{}, fmt.Errorf("cid: %w", err)
+	}
+	if decoded.Code != multihash.SHA2_256 || decoded.Length != sha256.Size {
+		return CID{}, fmt.Errorf("%w: multihash is not sha2-256", ErrInvalidCID)
+	}
+	return CID{Decoded: *decoded}, nil
+}
+
+// Sum returns the CIDv0 that "ipfs add" assigns to a file holding data
+func Sum(data []byte) CID {
+	// Reading from memory cannot fail
+	c, _ := SumReader(bytes.NewReader(data))
+	return c
+}
+
+// SumReader returns the CIDv0 that "ipfs add" assigns to a file holding
+// everything read from r. It keeps one chunk in memory at a time.
+func SumReader(r io.Reader) (CID, error) {
+	b := &dagBuilder{r: r, buf: make([]byte, ChunkSize)}
+	root, err := b.build()
+	if err != nil {
+		return CID{}, err
+	}
+	return fromDigest(root.digest[:]), nil
+}
+
+func fromDigest(digest []byte) CID {
+	return CID{Decoded: multihash.Decoded{
+		Code:   multihash.SHA2_256,
+		Name:   multihash.Name(multihash.SHA2_256),
+		Length: len(digest),
+		Digest: digest,
+	}}
+}
+
+// Multihash returns the binary multihash of the CID
+func (c CID) Multihash() multihash.Multihash {
+	return multihash.Encode(c.Digest, c.Code)
+}
+
+// String returns the Base58 encoding of the CID
+func (c CID) String() string {
+	return c.Multihash().B58String()
+}
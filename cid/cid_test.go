@@ -0,0 +1,116 @@
+package cid
+
+import (
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/jnst/base58"
+	"github.com/jnst/base58/multihash"
+)
+
+func TestSum(t *testing.T) {
+	// CIDs reported by "ipfs add" for files with these contents
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", "QmbFMke1KXqnYyBBWxB74N4c5SBnJMVAiMNRcGu6x1AwQH"},
+		{"hello world", "Qmf412jQZiuVUtdgnB36FXFX7xg5V6KEbSJ4dpQuhkLyfD"},
+		{"hello world\n", "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o"},
+	}
+
+	for _, tt := range tests {
+		if got := Sum([]byte(tt.input)).String(); got != tt.expected {
+			t.Errorf("Sum(%q) = %s, want %s", tt.input, got, tt.expected)
+		}
+
+		c, err := SumReader(strings.NewReader(tt.input))
+		if err != nil {
+			t.Fatalf("SumReader() error = %v", err)
+		}
+		if c.String() != tt.expected {
+			t.Errorf("SumReader(%q) = %s, want %s", tt.input, c, tt.expected)
+		}
+	}
+}
+
+// zeros is an endless reader of zero bytes
+type zeros struct{}
+
+func (zeros) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+func TestSumReaderChunked(t *testing.T) {
+	// Files of zeros around the chunk and link limits, matching the
+	// reference UnixFS importer's balanced layout
+	tests := []struct {
+		name     string
+		size     int64
+		expected string
+	}{
+		{"one full chunk", ChunkSize, "QmRk1rduJvo5DfEYAaLobS2za9tDszk35hzaNSDCJ74DA7"},
+		{"two chunks", ChunkSize + 1, "QmbVuw4C4vcmVKqxoWtgDVobvcHrSn51qsmQmyxjk4sB2Q"},
+		{"full first level", maxLinks * ChunkSize, "QmY4HSz1oVGdUzb8poVYPLsoqBZjH6LZrtgnme9wWn2Qko"},
+		{"second level", maxLinks*ChunkSize + 1, "QmehMASWcBsX7VcEQqs6rpR5AHoBfKyBVEgmkJHjpPg8jq"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := SumReader(io.LimitReader(zeros{}, tt.size))
+			if err != nil {
+				t.Fatalf("SumReader() error = %v", err)
+			}
+			if c.String() != tt.expected {
+				t.Errorf("SumReader(%d zero bytes) = %s, want %s", tt.size, c, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	const s = "QmdfTbBqBPQ7VNxZEYEj14VmRuZBkqFbiwReogJgS1zR1n"
+
+	c, err := Parse(s)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if c.Code != multihash.SHA2_256 || c.Name != "sha2-256" || c.Length != 32 {
+		t.Errorf("Parse() = code %x name %q length %d", c.Code, c.Name, c.Length)
+	}
+	if got := hex.EncodeToString(c.Digest); got != "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855" {
+		t.Errorf("Digest = %s", got)
+	}
+	if c.String() != s {
+		t.Errorf("String() = %q, want %q", c.String(), s)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	sha1, _ := multihash.Sum(make([]byte, 48), multihash.SHA1)
+
+	tests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{"wrong prefix", "zdfTbBqBPQ7VNxZEYEj14VmRuZBkqFbiwReogJgS1zR1n1", ErrInvalidCID},
+		{"too short", "QmdfTbBqBPQ7VNxZEYEj14VmRuZBkqFbiwReogJgS1zR1", ErrInvalidCID},
+		{"invalid character", "QmdfTbBqBPQ7VNxZEYEj14VmRuZBkqFbiwReogJgS1zR10", base58.ErrInvalidCharacter},
+		{"sha1 multihash", sha1.B58String(), ErrInvalidCID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.input); !errors.Is(err, tt.err) {
+				t.Errorf("Parse(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+		})
+	}
+}
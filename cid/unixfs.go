@@ -0,0 +1,164 @@
+package cid
+
+import (
+	"crypto/sha256"
+	"io"
+
+	"github.com/jnst/base58/internal/varint"
+	"github.com/jnst/base58/multihash"
+)
+
+const (
+	// ChunkSize is the fixed chunk size "ipfs add" splits files into by default
+	ChunkSize = 256 << 10
+	// maxLinks is the number of children per node in the balanced layout
+	maxLinks = 174
+)
+
+// Protobuf wire types
+const (
+	wireVarint = 0
+	wireBytes  = 2
+)
+
+// unixfsFile is the UnixFS Data.Type of a file node
+const unixfsFile = 2
+
+// node is a serialized DAG-PB block as seen from its parent link
+type node struct {
+	digest [sha256.Size]byte
+	// size is the block length plus the sizes of all blocks below it, the
+	// link's Tsize
+	size uint64
+	// fileSize is the number of file bytes under the node
+	fileSize uint64
+}
+
+// dagBuilder builds the balanced UnixFS DAG that "ipfs add" produces with
+// its defaults: fixed-size chunks, CIDv0 and no raw leaves
+type dagBuilder struct {
+	r     io.Reader
+	buf   []byte
+	chunk []byte
+	done  bool
+}
+
+// build reads r to the end and returns the root of its DAG
+func (b *dagBuilder) build() (node, error) {
+	if err := b.next(); err != nil {
+		return node{}, err
+	}
+	if b.done {
+		// An empty file is a leaf without a Data field
+		return newLeaf(nil), nil
+	}
+
+	root, err := b.leaf()
+	if err != nil {
+		return node{}, err
+	}
+	// Each pass adds a level above the current root and fills it
+	for depth := 1; !b.done; depth++ {
+		if root, err = b.fill([]node{root}, depth); err != nil {
+			return node{}, err
+		}
+	}
+	return root, nil
+}
+
+// next reads the following chunk, setting done at the end of the input
+func (b *dagBuilder) next() error {
+	n, err := io.ReadFull(b.r, b.buf)
+	switch err {
+	case nil, io.ErrUnexpectedEOF:
+		b.chunk = b.buf[:n]
+		return nil
+	case io.EOF:
+		b.done = true
+		return nil
+	default:
+		return err
+	}
+}
+
+// leaf turns the current chunk into a leaf and reads the next one
+func (b *dagBuilder) leaf() (node, error) {
+	n := newLeaf(b.chunk)
+	return n, b.next()
+}
+
+// fill adds children of the given depth until the node is full or the
+// input ends
+func (b *dagBuilder) fill(children []node, depth int) (node, error) {
+	for len(children) < maxLinks && !b.done {
+		var child node
+		var err error
+		if depth == 1 {
+			child, err = b.leaf()
+		} else {
+			child, err = b.fill(nil, depth-1)
+		}
+		if err != nil {
+			return node{}, err
+		}
+		children = append(children, child)
+	}
+	return newInternal(children), nil
+}
+
+// newLeaf encodes a PBNode holding the chunk as UnixFS file data. A nil
+// chunk omits the data field.
+func newLeaf(chunk []byte) node {
+	data := appendVarintField(nil, 1, unixfsFile)
+	if chunk != nil {
+		data = appendBytesField(data, 2, chunk)
+	}
+	data = appendVarintField(data, 3, uint64(len(chunk)))
+
+	block := appendBytesField(nil, 1, data)
+	return node{
+		digest:   sha256.Sum256(block),
+		size:     uint64(len(block)),
+		fileSize: uint64(len(chunk)),
+	}
+}
+
+// newInternal encodes a PBNode linking to children, with UnixFS data
+// recording the total file size and each child's share of it
+func newInternal(children []node) node {
+	var block []byte
+	var size, fileSize uint64
+	data := appendVarintField(nil, 1, unixfsFile)
+	for _, child := range children {
+		// PBLink: Hash, an empty Name, Tsize
+		link := appendBytesField(nil, 1, multihash.Encode(child.digest[:], multihash.SHA2_256))
+		link = appendBytesField(link, 2, nil)
+		link = appendVarintField(link, 3, child.size)
+		block = appendBytesField(block, 2, link)
+
+		size += child.size
+		fileSize += child.fileSize
+	}
+	data = appendVarintField(data, 3, fileSize)
+	for _, child := range children {
+		data = appendVarintField(data, 4, child.fileSize)
+	}
+	block = appendBytesField(block, 1, data)
+
+	return node{
+		digest:   sha256.Sum256(block),
+		size:     size + uint64(len(block)),
+		fileSize: fileSize,
+	}
+}
+
+func appendVarintField(dst []byte, field int, v uint64) []byte {
+	dst = varint.Append(dst, uint64(field<<3|wireVarint))
+	return varint.Append(dst, v)
+}
+
+func appendBytesField(dst []byte, field int, b []byte) []byte {
+	dst = varint.Append(dst, uint64(field<<3|wireBytes))
+	dst = varint.Append(dst, uint64(len(b)))
+	return append(dst, b...)
+}
@@ -1,21 +1,47 @@
 package main
 
 import (
+	"bufio"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"os"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/jnst/base58"
+	"github.com/jnst/base58/bip32"
+	"github.com/jnst/base58/bitcoin"
+	"github.com/jnst/base58/cid"
+	"github.com/jnst/base58/monero"
+	"github.com/jnst/base58/network"
 )
 
+// options holds the global flags shared by the commands
+type options struct {
+	file         string
+	stream       bool
+	maxSize      int
+	network      string
+	uncompressed bool
+	multibase    bool
+}
+
 func main() {
 	var (
 		help     = flag.Bool("h", false, "show help")
 		helpLong = flag.Bool("help", false, "show help")
-		file     = flag.String("f", "", "input file")
+		opts     options
 	)
+	flag.StringVar(&opts.file, "f", "", "input file")
+	flag.BoolVar(&opts.stream, "stream", false, "use block-framed streaming mode")
+	flag.IntVar(&opts.maxSize, "max-size", 0, "maximum decoded size in bytes (0 = unlimited)")
+	flag.BoolVar(&opts.multibase, "multibase", false, "add or require a multibase prefix ('z' base58btc, 'Z' base58flickr)")
+	flag.StringVar(&opts.network, "network", "mainnet", "network for generated keys (mainnet, testnet, regtest)")
+	flag.BoolVar(&opts.uncompressed, "uncompressed", false, "generate WIF keys for uncompressed public keys")
 	flag.Parse()
 
 	if *help || *helpLong {
@@ -32,12 +58,32 @@ func main() {
 	command := args[0]
 	switch command {
 	case "encode":
-		if err := encodeCommand(*file, args[1:]); err != nil {
+		if err := encodeCommand(opts, args[1:]); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
 	case "decode":
-		if err := decodeCommand(*file, args[1:]); err != nil {
+		if err := decodeCommand(opts, args[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	case "validate":
+		if err := validateCommand(opts, args[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	case "wif":
+		if err := wifCommand(opts, args[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	case "inspect":
+		if err := inspectCommand(opts, args[1:]); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	case "cid":
+		if err := cidCommand(opts, args[1:]); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
@@ -58,11 +104,21 @@ func showHelp() {
 	fmt.Println("  base58 encode -f <file>     Encode file contents as base58")
 	fmt.Println("  base58 decode [base58]      Decode base58 string")
 	fmt.Println("  base58 decode -f <file>     Decode base58 from file")
+	fmt.Println("  base58 validate [base58]    Validate base58 strings, one per line")
+	fmt.Println("  base58 validate -f <file>   Validate each line of a file")
+	fmt.Println("  base58 wif <hex|wif>        Convert between a hex private key and WIF")
+	fmt.Println("  base58 inspect <base58>     Describe an extended key, address (incl. Monero) or WIF key")
+	fmt.Println("  base58 cid [file]           Print the CIDv0 that ipfs add assigns to a file")
 	fmt.Println("  base58 help                 Show this help")
 	fmt.Println()
 	fmt.Println("Options:")
-	fmt.Println("  -f <file>     Read input from file")
-	fmt.Println("  -h, --help    Show help")
+	fmt.Println("  -f <file>         Read input from file")
+	fmt.Println("  -stream           Encode/decode in 8-byte blocks without buffering the whole input")
+	fmt.Println("  --max-size <n>    Reject input that decodes to more than n bytes")
+	fmt.Println("  --multibase       Prefix encoded output with 'z'; require a 'z' or 'Z' prefix when decoding")
+	fmt.Println("  -network <name>   Network for generated keys: mainnet, testnet, regtest")
+	fmt.Println("  -uncompressed     Generate WIF keys for uncompressed public keys")
+	fmt.Println("  -h, --help        Show help")
 	fmt.Println()
 	fmt.Println("Examples:")
 	fmt.Println("  echo 'Hello World' | base58 encode")
@@ -70,9 +126,37 @@ func showHelp() {
 	fmt.Println("  base58 encode -f input.txt")
 	fmt.Println("  base58 decode JxF12TrwUP45BMd")
 	fmt.Println("  echo 'JxF12TrwUP45BMd' | base58 decode")
+	fmt.Println("  base58 -stream encode < large.bin | base58 -stream decode")
+	fmt.Println("  base58 --multibase decode zJxF12TrwUP45BMd")
+	fmt.Println("  base58 -f keys.txt validate")
+	fmt.Println("  base58 inspect xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8")
+	fmt.Println("  base58 -network testnet wif 0000000000000000000000000000000000000000000000000000000000000001")
+}
+
+// openInput returns a reader over the file, the joined arguments, or stdin
+func openInput(filename string, args []string) (io.ReadCloser, error) {
+	if filename != "" {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, fmt.Errorf("reading file: %w", err)
+		}
+		return f, nil
+	}
+	if len(args) > 0 {
+		return io.NopCloser(strings.NewReader(strings.Join(args, " "))), nil
+	}
+	return io.NopCloser(os.Stdin), nil
 }
 
-func encodeCommand(filename string, args []string) error {
+func encodeCommand(opts options, args []string) error {
+	filename := opts.file
+	if opts.stream && opts.multibase {
+		return errors.New("--multibase cannot be combined with -stream")
+	}
+	if opts.stream {
+		return encodeStream(filename, args)
+	}
+
 	var input []byte
 	var err error
 
@@ -90,12 +174,41 @@ func encodeCommand(filename string, args []string) error {
 		}
 	}
 
-	encoded := base58.Encode(input)
-	fmt.Println(encoded)
+	if opts.multibase {
+		fmt.Println(base58.MultibaseEncode(input))
+	} else {
+		fmt.Println(base58.Encode(input))
+	}
+	return nil
+}
+
+func encodeStream(filename string, args []string) error {
+	input, err := openInput(filename, args)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	encoder := base58.NewEncoder(os.Stdout)
+	if _, err := io.Copy(encoder, input); err != nil {
+		return fmt.Errorf("encoding: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("encoding: %w", err)
+	}
+	fmt.Println()
 	return nil
 }
 
-func decodeCommand(filename string, args []string) error {
+func decodeCommand(opts options, args []string) error {
+	filename := opts.file
+	if opts.stream && opts.multibase {
+		return errors.New("--multibase cannot be combined with -stream")
+	}
+	if opts.stream {
+		return decodeStream(filename, args, opts.maxSize)
+	}
+
 	var input string
 	var err error
 
@@ -115,8 +228,18 @@ func decodeCommand(filename string, args []string) error {
 		input = strings.TrimSpace(string(data))
 	}
 
-	decoded, err := base58.Decode(input)
+	decodeOpts := base58.DecodeOptions{MaxDecodedLen: opts.maxSize}
+	var decoded []byte
+	if opts.multibase {
+		decoded, err = base58.MultibaseDecodeWithOptions(input, decodeOpts)
+	} else {
+		decoded, err = base58.DecodeWithOptions(input, decodeOpts)
+	}
 	if err != nil {
+		var corrupt *base58.CorruptInputError
+		if errors.As(err, &corrupt) {
+			printCaret(input, corrupt.Offset)
+		}
 		return fmt.Errorf("decoding: %w", err)
 	}
 
@@ -124,3 +247,277 @@ func decodeCommand(filename string, args []string) error {
 	return nil
 }
 
+func decodeStream(filename string, args []string, maxSize int) error {
+	input, err := openInput(filename, args)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	decoder := base58.NewDecoder(input)
+	if maxSize <= 0 {
+		if _, err := io.Copy(os.Stdout, decoder); err != nil {
+			return fmt.Errorf("decoding: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := io.Copy(os.Stdout, io.LimitReader(decoder, int64(maxSize))); err != nil {
+		return fmt.Errorf("decoding: %w", err)
+	}
+	// Any further byte means the input exceeds the limit
+	var extra [1]byte
+	switch _, err := io.ReadFull(decoder, extra[:]); err {
+	case nil:
+		return fmt.Errorf("decoding: %w", &base58.LimitError{Limit: maxSize, Size: maxSize + 1, Decoded: true})
+	case io.EOF:
+		return nil
+	default:
+		return fmt.Errorf("decoding: %w", err)
+	}
+}
+
+// validateCommand checks each non-empty input line and reports the result
+// per line, failing if any line is invalid
+func validateCommand(opts options, args []string) error {
+	var input io.ReadCloser
+	if opts.file == "" && len(args) > 0 {
+		input = io.NopCloser(strings.NewReader(strings.Join(args, "\n")))
+	} else {
+		var err error
+		input, err = openInput(opts.file, nil)
+		if err != nil {
+			return err
+		}
+	}
+	defer input.Close()
+
+	// Read whole lines however long they are, so one oversized line is
+	// reported like any other instead of aborting the run
+	invalid := 0
+	reader := bufio.NewReader(input)
+	for line := 1; ; line++ {
+		raw, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("reading input: %w", err)
+		}
+		if text := strings.TrimSpace(raw); text != "" {
+			if verr := base58.Validate(text); verr != nil {
+				fmt.Printf("line %d: %v\n", line, verr)
+				invalid++
+			} else {
+				fmt.Printf("line %d: ok\n", line)
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+
+	if invalid > 0 {
+		return fmt.Errorf("%d invalid line(s)", invalid)
+	}
+	return nil
+}
+
+// cidCommand prints the CIDv0 that "ipfs add" assigns to a file or stdin.
+// The argument is a file name rather than data, unlike encode.
+func cidCommand(opts options, args []string) error {
+	filename := opts.file
+	if len(args) > 0 {
+		filename = args[0]
+	}
+
+	input, err := openInput(filename, nil)
+	if err != nil {
+		return err
+	}
+	defer input.Close()
+
+	c, err := cid.SumReader(input)
+	if err != nil {
+		return fmt.Errorf("reading input: %w", err)
+	}
+	fmt.Println(c)
+	return nil
+}
+
+// wifCommand encodes a hex private key as WIF, or decodes a WIF key to hex
+func wifCommand(opts options, args []string) error {
+	input, err := readArgument(opts.file, args)
+	if err != nil {
+		return err
+	}
+
+	if secret, err := hex.DecodeString(input); err == nil {
+		net, err := bitcoin.ParseNetwork(opts.network)
+		if err != nil {
+			return err
+		}
+		encoded, err := bitcoin.EncodeWIF(secret, net, !opts.uncompressed)
+		if err != nil {
+			return fmt.Errorf("encoding: %w", err)
+		}
+		fmt.Println(encoded)
+		return nil
+	}
+
+	w, err := bitcoin.DecodeWIF(input)
+	if err != nil {
+		return fmt.Errorf("decoding: %w", err)
+	}
+	fmt.Printf("private key: %x\n", w.PrivateKey)
+	fmt.Printf("network:     %s\n", w.Network)
+	fmt.Printf("compressed:  %t\n", w.Compressed)
+	return nil
+}
+
+// inspectCommand identifies a Base58 string and prints its fields
+func inspectCommand(opts options, args []string) error {
+	input, err := readArgument(opts.file, args)
+	if err != nil {
+		return err
+	}
+
+	if key, err := bip32.Parse(input); err == nil {
+		printExtendedKey(key)
+		return nil
+	}
+	if addr, err := monero.ParseAddress(input); err == nil {
+		printField("type", fmt.Sprintf("monero %s address", addr.Type))
+		printField("network", addr.Network.String())
+		printField("spend key", hex.EncodeToString(addr.SpendKey[:]))
+		printField("view key", hex.EncodeToString(addr.ViewKey[:]))
+		if addr.Type == monero.Integrated {
+			printField("payment id", hex.EncodeToString(addr.PaymentID[:]))
+		}
+		return nil
+	}
+	if matches, err := network.Identify(input); err == nil {
+		printNetworkMatches(matches)
+		return nil
+	}
+
+	decoded, err := base58.Decode(input)
+	if err != nil {
+		var corrupt *base58.CorruptInputError
+		if errors.As(err, &corrupt) {
+			printCaret(input, corrupt.Offset)
+		}
+		return fmt.Errorf("decoding: %w", err)
+	}
+	printField("type", "raw base58")
+	printField("length", fmt.Sprint(len(decoded)))
+	printField("hex", hex.EncodeToString(decoded))
+	return nil
+}
+
+func printExtendedKey(key *bip32.ExtendedKey) {
+	info, _ := key.Info()
+	kind := "public"
+	if info.Private {
+		kind = "private"
+	}
+
+	printField("type", fmt.Sprintf("extended %s key (%s)", kind, info.Prefix))
+	printField("network", info.Network.String())
+	printField("script", info.Script)
+	printExtendedKeyFields(key, info.Private)
+}
+
+// printExtendedKeyFields prints the serialized fields of an extended key.
+// The caller says whether it is private, since versions outside the bip32
+// table are only known through the network registry.
+func printExtendedKeyFields(key *bip32.ExtendedKey, private bool) {
+	child := fmt.Sprint(key.ChildNumber)
+	if key.IsHardened() {
+		child = fmt.Sprintf("%d'", key.ChildNumber-bip32.HardenedOffset)
+	}
+
+	printField("depth", fmt.Sprint(key.Depth))
+	printField("parent fingerprint", hex.EncodeToString(key.ParentFingerprint[:]))
+	printField("child number", child)
+	printField("chain code", hex.EncodeToString(key.ChainCode[:]))
+	if private {
+		// Never echo private key material
+		printField("private key", "(hidden)")
+	} else {
+		printField("public key", hex.EncodeToString(key.KeyData[:]))
+	}
+}
+
+// printNetworkMatches prints an address, WIF key or extended key with every
+// network that shares its version bytes
+func printNetworkMatches(matches []network.Match) {
+	kind := matches[0].Kind
+	var names []string
+	for _, m := range matches {
+		if m.Kind == kind {
+			names = append(names, m.Network.Name)
+		}
+	}
+
+	payload := matches[0].Payload
+	switch kind {
+	case network.PrivateKey:
+		printField("type", "WIF private key")
+		printField("networks", strings.Join(names, ", "))
+		printField("compressed", fmt.Sprint(len(payload) > bitcoin.PrivateKeySize))
+	case network.PubKeyHash, network.ScriptHash:
+		printField("type", fmt.Sprintf("address (%s)", kind))
+		printField("networks", strings.Join(names, ", "))
+		printField("hash160", hex.EncodeToString(payload))
+	case network.HDPublic, network.HDPrivate:
+		printField("type", kind.String())
+		printField("networks", strings.Join(names, ", "))
+		printExtendedKeyFields(extendedKeyFromPayload(payload), kind == network.HDPrivate)
+	}
+}
+
+// extendedKeyFromPayload splits the 74 bytes following an extended key's
+// version into its fields
+func extendedKeyFromPayload(payload []byte) *bip32.ExtendedKey {
+	key := &bip32.ExtendedKey{
+		Depth:       payload[0],
+		ChildNumber: binary.BigEndian.Uint32(payload[5:9]),
+	}
+	copy(key.ParentFingerprint[:], payload[1:5])
+	copy(key.ChainCode[:], payload[9:9+bip32.ChainCodeLen])
+	copy(key.KeyData[:], payload[9+bip32.ChainCodeLen:])
+	return key
+}
+
+// printField prints one aligned "name: value" line of inspect output
+func printField(name, value string) {
+	fmt.Printf("%-20s%s\n", name+":", value)
+}
+
+// readArgument returns the trimmed input from the file, arguments, or stdin
+func readArgument(filename string, args []string) (string, error) {
+	input, err := openInput(filename, args)
+	if err != nil {
+		return "", err
+	}
+	defer input.Close()
+
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return "", fmt.Errorf("reading input: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// printCaret prints the line of input containing offset with a caret under
+// the character at offset
+func printCaret(input string, offset int) {
+	start := strings.LastIndexByte(input[:offset], '\n') + 1
+	end := strings.IndexByte(input[offset:], '\n')
+	if end < 0 {
+		end = len(input)
+	} else {
+		end += offset
+	}
+
+	fmt.Fprintln(os.Stderr, input[start:end])
+	fmt.Fprintln(os.Stderr, strings.Repeat(" ", utf8.RuneCountInString(input[start:offset]))+"^")
+}
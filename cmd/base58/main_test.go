@@ -223,3 +223,399 @@ func TestCLIDecodeError(t *testing.T) {
 		t.Errorf("Should show decode error")
 	}
 }
+
+func TestCLIStream(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		input    string
+		expected string
+	}{
+		{
+			name:     "stream encode argument",
+			args:     []string{"-stream", "encode", "Hello World"},
+			expected: "D7LMXYjUbXc1fS9Z\n",
+		},
+		{
+			name:     "stream encode from stdin",
+			args:     []string{"-stream", "encode"},
+			input:    "Hello World",
+			expected: "D7LMXYjUbXc1fS9Z\n",
+		},
+		{
+			name:     "stream decode from stdin with newline",
+			args:     []string{"-stream", "decode"},
+			input:    "D7LMXYjUbXc1fS9Z\n",
+			expected: "Hello World",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command("go", append([]string{"run", "main.go"}, tt.args...)...)
+			cmd.Dir = "./"
+
+			var stdout bytes.Buffer
+			cmd.Stdout = &stdout
+
+			if tt.input != "" {
+				cmd.Stdin = strings.NewReader(tt.input)
+			}
+
+			err := cmd.Run()
+			if err != nil {
+				t.Fatalf("Command failed: %v", err)
+			}
+
+			result := stdout.String()
+			if result != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCLIDecodeErrorCaret(t *testing.T) {
+	cmd := exec.Command("go", "run", "main.go", "decode", "JxF12Trw0P45BMd")
+	cmd.Dir = "./"
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err == nil {
+		t.Fatalf("Expected command to fail")
+	}
+
+	result := stderr.String()
+	if !strings.Contains(result, "JxF12Trw0P45BMd\n        ^\n") {
+		t.Errorf("Should point at the invalid character, got %q", result)
+	}
+	if !strings.Contains(result, "at offset 8") {
+		t.Errorf("Should report the offset, got %q", result)
+	}
+}
+
+func TestCLIMaxSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{
+			name: "within limit",
+			args: []string{"--max-size", "11", "decode", "JxF12TrwUP45BMd"},
+		},
+		{
+			name:    "exceeds limit",
+			args:    []string{"--max-size", "10", "decode", "JxF12TrwUP45BMd"},
+			wantErr: "exceeds limit 10",
+		},
+		{
+			name:    "stream exceeds limit",
+			args:    []string{"-stream", "--max-size", "10", "decode", "D7LMXYjUbXc1fS9Z"},
+			wantErr: "exceeds limit 10",
+		},
+		{
+			// The limit is reached exactly, but the next block is corrupt
+			name:    "stream invalid character after limit",
+			args:    []string{"-stream", "--max-size", "16", "decode", "94XRDHZYFLSAQSnfVsfqh70OOO"},
+			wantErr: "invalid base58 character '0' at offset 22",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command("go", append([]string{"run", "main.go"}, tt.args...)...)
+			cmd.Dir = "./"
+
+			var stderr bytes.Buffer
+			cmd.Stderr = &stderr
+
+			err := cmd.Run()
+			if tt.wantErr != "" {
+				if err == nil {
+					t.Fatalf("Expected command to fail")
+				}
+				if !strings.Contains(stderr.String(), tt.wantErr) {
+					t.Errorf("Should report %q, got %q", tt.wantErr, stderr.String())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Command failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestCLIValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "valid argument",
+			args:     []string{"validate", "JxF12TrwUP45BMd"},
+			expected: "line 1: ok\n",
+		},
+		{
+			name:     "lines from stdin",
+			args:     []string{"validate"},
+			input:    "JxF12TrwUP45BMd\n\nJxF12Trw0P45BMd\n",
+			expected: "line 1: ok\nline 3: invalid base58 character '0' at offset 8\n",
+			wantErr:  true,
+		},
+		{
+			// Longer than bufio.Scanner's default 64 KiB token limit
+			name:     "long line does not stop the run",
+			args:     []string{"validate"},
+			input:    strings.Repeat("z", 100*1024) + "0\nJxF12TrwUP45BMd",
+			expected: "line 1: invalid base58 character '0' at offset 102400\nline 2: ok\n",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command("go", append([]string{"run", "main.go"}, tt.args...)...)
+			cmd.Dir = "./"
+
+			var stdout bytes.Buffer
+			cmd.Stdout = &stdout
+
+			if tt.input != "" {
+				cmd.Stdin = strings.NewReader(tt.input)
+			}
+
+			err := cmd.Run()
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Command error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if stdout.String() != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, stdout.String())
+			}
+		})
+	}
+}
+
+func TestCLIWIF(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "hex to compressed WIF",
+			args:     []string{"wif", "0000000000000000000000000000000000000000000000000000000000000001"},
+			expected: "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn\n",
+		},
+		{
+			name:     "hex to uncompressed testnet WIF",
+			args:     []string{"-network", "testnet", "-uncompressed", "wif", "0000000000000000000000000000000000000000000000000000000000000001"},
+			expected: "91avARGdfge8E4tZfYLoxeJ5sGBdNJQH4kvjJoQFacbgwmaKkrx\n",
+		},
+		{
+			name: "WIF to hex",
+			args: []string{"wif", "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn"},
+			expected: "private key: 0000000000000000000000000000000000000000000000000000000000000001\n" +
+				"network:     mainnet\n" +
+				"compressed:  true\n",
+		},
+		{
+			name:    "invalid checksum",
+			args:    []string{"wif", "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWo"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command("go", append([]string{"run", "main.go"}, tt.args...)...)
+			cmd.Dir = "./"
+
+			var stdout bytes.Buffer
+			cmd.Stdout = &stdout
+
+			err := cmd.Run()
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Command error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if stdout.String() != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, stdout.String())
+			}
+		})
+	}
+}
+
+func TestCLIInspect(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		contains []string
+		excludes []string
+	}{
+		{
+			name:  "extended public key",
+			input: "xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw",
+			contains: []string{
+				"extended public key (xpub)",
+				"depth:              1\n",
+				"parent fingerprint: 3442193e\n",
+				"child number:       0'\n",
+				"public key:         035a784662a4a20a65bf6aab9ae98a6c068a81c52e4b032c0fb5400c706cfccc56\n",
+			},
+		},
+		{
+			name:     "extended private key",
+			input:    "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi",
+			contains: []string{"extended private key (xprv)", "private key:        (hidden)\n"},
+		},
+		{
+			// BIP32 test vector 1 master keys under dogecoin's dgub/dgpv
+			// versions, which only the network registry knows
+			name:  "dogecoin extended public key",
+			input: "dgub8kXBZ7ymNWy2S8Q3jNgVjFUm5ZJ3QLLaSTdAA89ukSv7Q6MSXwE14b7Nv6eDpE9JJXinTKc8LeLVu19uDPrm5uJuhpKNzV2kAgncwo6bNpP",
+			contains: []string{
+				"type:               extended public key\n",
+				"networks:           dogecoin\n",
+				"chain code:         873dff81c02f525623fd1fe5167eac3a55a049de3d314bb42ee227ffed37d508\n",
+				"public key:         0339a36013301597daef41fbe593a02cc513d0b55527ec2df1050e2e8ff49c85c2\n",
+			},
+			excludes: []string{"hash160"},
+		},
+		{
+			name:     "dogecoin extended private key",
+			input:    "dgpv51eADS3spNJh9Gjth94XcPwAczvQaDJs9rqx11kvxKs6r3Ek8AgERHhjLs6mzXQFHRzQqGwqdeoDkZmr8jQMBfi43b7sT3sx3cCSk5fGeUR",
+			contains: []string{"type:               extended private key\n", "networks:           dogecoin\n", "private key:        (hidden)\n"},
+			excludes: []string{"hash160", "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		},
+		{
+			name:     "address",
+			input:    "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+			contains: []string{"address (p2pkh)", "networks:           bitcoin, bitcoin-cash\n", "751e76e8199196d454941c45d1b3a323f1433bd6"},
+		},
+		{
+			name:     "dogecoin address",
+			input:    "DBXu2kgc3xtvCUWFcxFE3r9hEYgmuaaCyD",
+			contains: []string{"address (p2pkh)", "networks:           dogecoin\n"},
+		},
+		{
+			name:     "monero address",
+			input:    "44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A",
+			contains: []string{"monero standard address", "network:            mainnet\n", "spend key:", "view key:"},
+		},
+		{
+			name:     "raw",
+			input:    "JxF12TrwUP45BMd",
+			contains: []string{"raw base58", "48656c6c6f20576f726c64"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command("go", "run", "main.go", "inspect", tt.input)
+			cmd.Dir = "./"
+
+			output, err := cmd.Output()
+			if err != nil {
+				t.Fatalf("Command failed: %v", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(string(output), want) {
+					t.Errorf("Output %q does not contain %q", output, want)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(string(output), unwanted) {
+					t.Errorf("Output %q contains %q", output, unwanted)
+				}
+			}
+		})
+	}
+}
+
+func TestCLICID(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := tmpDir + "/hello.txt"
+	if err := os.WriteFile(testFile, []byte("hello world\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		args  []string
+		input string
+	}{
+		{"file argument", []string{"cid", testFile}, ""},
+		{"stdin", []string{"cid"}, "hello world\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command("go", append([]string{"run", "main.go"}, tt.args...)...)
+			cmd.Dir = "./"
+			cmd.Stdin = strings.NewReader(tt.input)
+
+			output, err := cmd.Output()
+			if err != nil {
+				t.Fatalf("Command failed: %v", err)
+			}
+			if string(output) != "QmT78zSuBmuS4z925WZfrqQ1qHaJ56DQaTfyMUF7F8ff5o\n" {
+				t.Errorf("Expected CID of hello world, got %q", output)
+			}
+		})
+	}
+}
+
+func TestCLIMultibase(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "encode",
+			args:     []string{"--multibase", "encode", "Hello World"},
+			expected: "zJxF12TrwUP45BMd\n",
+		},
+		{
+			name:     "decode base58btc",
+			args:     []string{"--multibase", "decode", "zJxF12TrwUP45BMd"},
+			expected: "Hello World",
+		},
+		{
+			name:    "decode unsupported prefix",
+			args:    []string{"--multibase", "decode", "bjbswy3dp"},
+			wantErr: true,
+		},
+		{
+			name:    "decode without prefix",
+			args:    []string{"--multibase", "decode", "JxF12TrwUP45BMd"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command("go", append([]string{"run", "main.go"}, tt.args...)...)
+			cmd.Dir = "./"
+
+			var stdout bytes.Buffer
+			cmd.Stdout = &stdout
+
+			err := cmd.Run()
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("Command error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if stdout.String() != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, stdout.String())
+			}
+		})
+	}
+}
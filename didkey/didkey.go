@@ -0,0 +1,130 @@
+// Package didkey converts between raw public keys and did:key identifiers,
+// which are base58btc multibase strings over a multicodec-prefixed key.
+package didkey
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jnst/base58"
+	"github.com/jnst/base58/internal/varint"
+)
+
+// Prefix starts every did:key identifier
+const Prefix = "did:key:"
+
+// KeyType is the multicodec code of a public key type
+type KeyType uint64
+
+// Supported key types
+const (
+	Ed25519   KeyType = 0xed
+	X25519    KeyType = 0xec
+	Secp256k1 KeyType = 0xe7
+	P256      KeyType = 0x1200
+)
+
+// keySizes is the public key length of each supported type. The ECDSA
+// curves use compressed SEC1 points.
+var keySizes = map[KeyType]int{
+	Ed25519:   32,
+	X25519:    32,
+	Secp256k1: 33,
+	P256:      33,
+}
+
+var (
+	// ErrInvalidDID is returned when a string is not a did:key with a
+	// base58btc multibase identifier
+	ErrInvalidDID = errors.New("didkey: not a base58btc did:key")
+	// ErrInvalidMulticodec is returned when the multicodec varint is malformed
+	ErrInvalidMulticodec = errors.New("didkey: invalid multicodec")
+	// ErrUnsupportedKeyType is returned for multicodec codes other than the
+	// supported key types
+	ErrUnsupportedKeyType = errors.New("didkey: unsupported key type")
+	// ErrInvalidKey is returned when a key has the wrong length or point format
+	ErrInvalidKey = errors.New("didkey: invalid public key")
+)
+
+// String returns the multicodec name of the key type
+func (t KeyType) String() string {
+	switch t {
+	case Ed25519:
+		return "ed25519-pub"
+	case X25519:
+		return "x25519-pub"
+	case Secp256k1:
+		return "secp256k1-pub"
+	case P256:
+		return "p256-pub"
+	default:
+		return fmt.Sprintf("0x%x", uint64(t))
+	}
+}
+
+// PublicKey is a public key of a known type
+type PublicKey struct {
+	Type KeyType
+	Key  []byte
+}
+
+// Encode returns the did:key identifier for a raw public key
+func Encode(t KeyType, key []byte) (string, error) {
+	if err := validateKey(t, key); err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, 0, varint.Len(uint64(t))+len(key))
+	buf = varint.Append(buf, uint64(t))
+	buf = append(buf, key...)
+	return Prefix + base58.MultibaseEncode(buf), nil
+}
+
+// Parse decodes a did:key identifier into its key type and raw public key
+func Parse(did string) (PublicKey, error) {
+	id := strings.TrimPrefix(did, Prefix)
+	if len(id) == len(did) || !strings.HasPrefix(id, string(base58.MultibaseBitcoin)) {
+		return PublicKey{}, ErrInvalidDID
+	}
+
+	data, err := base58.MultibaseDecode(id)
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("didkey: %w", err)
+	}
+
+	code, n, err := varint.Read(data)
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("%w: %v", ErrInvalidMulticodec, err)
+	}
+	t := KeyType(code)
+	key := data[n:]
+	if err := validateKey(t, key); err != nil {
+		return PublicKey{}, err
+	}
+	return PublicKey{Type: t, Key: key}, nil
+}
+
+// String returns the did:key identifier of the key
+func (k PublicKey) String() string {
+	did, err := Encode(k.Type, k.Key)
+	if err != nil {
+		return ""
+	}
+	return did
+}
+
+// validateKey checks the key length and, for ECDSA curves, the compressed point prefix
+func validateKey(t KeyType, key []byte) error {
+	size, ok := keySizes[t]
+	if !ok {
+		return fmt.Errorf("%w %s", ErrUnsupportedKeyType, t)
+	}
+	if len(key) != size {
+		return fmt.Errorf("%w: %s key must be %d bytes, got %d", ErrInvalidKey, t, size, len(key))
+	}
+	if (t == Secp256k1 || t == P256) && key[0] != 0x02 && key[0] != 0x03 {
+		return fmt.Errorf("%w: %s key must be a compressed point", ErrInvalidKey, t)
+	}
+	return nil
+}
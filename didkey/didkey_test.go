@@ -0,0 +1,109 @@
+package didkey
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jnst/base58"
+	"github.com/jnst/base58/internal/varint"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		did     string
+		keyType KeyType
+	}{
+		{"did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK", Ed25519},
+		{"did:key:z6LSeu9HkTHSfLLeUs2nnzUSNedgDUevfNQgQjQC23ZCit6F", X25519},
+		{"did:key:zQ3shokFTS3brHcDQrn82RUDfCZESWL1ZdCEJwekUDPQiYBme", Secp256k1},
+		{"did:key:zDnaerDaTF5BXEavCrfRZEk316dpbLsfPDZ3WJ5hRTPFU2169", P256},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.keyType.String(), func(t *testing.T) {
+			key, err := Parse(tt.did)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if key.Type != tt.keyType {
+				t.Errorf("Type = %v, want %v", key.Type, tt.keyType)
+			}
+			if len(key.Key) != keySizes[tt.keyType] {
+				t.Errorf("len(Key) = %d, want %d", len(key.Key), keySizes[tt.keyType])
+			}
+			if key.String() != tt.did {
+				t.Errorf("String() = %q, want %q", key.String(), tt.did)
+			}
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	pub := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)).Public().(ed25519.PublicKey)
+	compressed, _ := hex.DecodeString("0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798")
+
+	tests := []struct {
+		keyType KeyType
+		key     []byte
+		prefix  string
+	}{
+		{Ed25519, pub, "did:key:z6Mk"},
+		{X25519, pub, "did:key:z6LS"},
+		{Secp256k1, compressed, "did:key:zQ3s"},
+		{P256, compressed, "did:key:zDn"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.keyType.String(), func(t *testing.T) {
+			did, err := Encode(tt.keyType, tt.key)
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			if !strings.HasPrefix(did, tt.prefix) {
+				t.Errorf("Encode() = %q, want prefix %q", did, tt.prefix)
+			}
+
+			key, err := Parse(did)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if key.Type != tt.keyType || string(key.Key) != string(tt.key) {
+				t.Errorf("Parse() = %v %x, want %v %x", key.Type, key.Key, tt.keyType, tt.key)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	key := make([]byte, 32)
+	encode := func(prefix []byte, key []byte) string {
+		return Prefix + base58.MultibaseEncode(append(prefix, key...))
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{"missing method", "z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK", ErrInvalidDID},
+		{"other method", "did:web:example.com", ErrInvalidDID},
+		{"flickr multibase", "did:key:Z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK", ErrInvalidDID},
+		{"invalid character", "did:key:z6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2do0", base58.ErrInvalidCharacter},
+		{"truncated varint", encode([]byte{0xed}, nil), ErrInvalidMulticodec},
+		{"non-minimal varint", encode([]byte{0xed, 0x81, 0x00}, key), ErrInvalidMulticodec},
+		{"unsupported type", encode(varint.Append(nil, 0x1205), key), ErrUnsupportedKeyType},
+		{"short key", encode([]byte{0xed, 0x01}, key[:31]), ErrInvalidKey},
+		{"uncompressed secp256k1", encode([]byte{0xe7, 0x01}, append([]byte{0x04}, key...)), ErrInvalidKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.input); !errors.Is(err, tt.err) {
+				t.Errorf("Parse(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+		})
+	}
+}
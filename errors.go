@@ -0,0 +1,86 @@
+package base58
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Sentinel errors, usable with errors.Is
+var (
+	// ErrInvalidCharacter is returned when decoding input that contains a
+	// character outside the alphabet. The concrete error is a *CorruptInputError.
+	ErrInvalidCharacter = errors.New("invalid base58 character")
+	// ErrChecksum is returned when a Base58Check checksum does not match
+	ErrChecksum = errors.New("invalid base58 checksum")
+	// ErrTooShort is returned when Base58Check input is too short to hold
+	// its version and checksum
+	ErrTooShort = errors.New("base58 input too short")
+	// ErrInvalidVersionLength is returned when a checked decode is asked for
+	// a negative version length
+	ErrInvalidVersionLength = errors.New("base58 version length must not be negative")
+	// ErrInvalidBlock is returned when a block of block-framed input has an
+	// impossible length or encodes a value that does not fit the block
+	ErrInvalidBlock = errors.New("invalid base58 block")
+	// ErrInvalidLength is returned when input does not decode to the
+	// required number of bytes
+	ErrInvalidLength = errors.New("invalid base58 decoded length")
+	// ErrTooLarge is returned when input exceeds a DecodeOptions limit. The
+	// concrete error is a *LimitError.
+	ErrTooLarge = errors.New("base58 input exceeds size limit")
+	// ErrUnsupportedMultibase is returned when multibase input is empty or
+	// its prefix is not 'z' (base58btc) or 'Z' (base58flickr), or when
+	// multibase encoding with an alphabet that has no multibase code
+	ErrUnsupportedMultibase = errors.New("unsupported multibase prefix")
+)
+
+// Errors returned by NewEncoding
+var (
+	ErrInvalidAlphabetLength = errors.New("base58 alphabet must be 58 bytes long")
+	ErrDuplicateAlphabetChar = errors.New("base58 alphabet contains duplicate characters")
+)
+
+// CorruptInputError reports a character outside the alphabet
+type CorruptInputError struct {
+	// Offset is the byte offset of the character in the input
+	Offset int
+	// Char is the offending character
+	Char rune
+}
+
+func (e *CorruptInputError) Error() string {
+	return fmt.Sprintf("invalid base58 character %q at offset %d", e.Char, e.Offset)
+}
+
+// Is reports whether target is ErrInvalidCharacter
+func (e *CorruptInputError) Is(target error) bool {
+	return target == ErrInvalidCharacter
+}
+
+// corruptInputError returns a *CorruptInputError for the character at offset in s
+func corruptInputError(s string, offset int) *CorruptInputError {
+	char, _ := utf8.DecodeRuneInString(s[offset:])
+	return &CorruptInputError{Offset: offset, Char: char}
+}
+
+// LimitError reports input that exceeds a DecodeOptions limit
+type LimitError struct {
+	// Limit is the exceeded limit
+	Limit int
+	// Size is the input length, or the (minimum) decoded length if Decoded is set
+	Size int
+	// Decoded reports whether MaxDecodedLen rather than MaxInputLen was exceeded
+	Decoded bool
+}
+
+func (e *LimitError) Error() string {
+	if e.Decoded {
+		return fmt.Sprintf("base58 decoded length %d exceeds limit %d", e.Size, e.Limit)
+	}
+	return fmt.Sprintf("base58 input length %d exceeds limit %d", e.Size, e.Limit)
+}
+
+// Is reports whether target is ErrTooLarge
+func (e *LimitError) Is(target error) bool {
+	return target == ErrTooLarge
+}
@@ -0,0 +1,81 @@
+package base58
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCorruptInputError(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		offset int
+		char   rune
+	}{
+		{
+			name:   "zero digit",
+			input:  "1230",
+			offset: 3,
+			char:   '0',
+		},
+		{
+			name:   "after leading ones",
+			input:  "11l",
+			offset: 2,
+			char:   'l',
+		},
+		{
+			name:   "first character",
+			input:  "O23",
+			offset: 0,
+			char:   'O',
+		},
+		{
+			name:   "multi-byte character",
+			input:  "JxF€12",
+			offset: 3,
+			char:   '€',
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode(tt.input)
+			if !errors.Is(err, ErrInvalidCharacter) {
+				t.Fatalf("Decode(%q) error = %v, want ErrInvalidCharacter", tt.input, err)
+			}
+
+			var corrupt *CorruptInputError
+			if !errors.As(err, &corrupt) {
+				t.Fatalf("Decode(%q) error %T is not a *CorruptInputError", tt.input, err)
+			}
+			if corrupt.Offset != tt.offset || corrupt.Char != tt.char {
+				t.Errorf("Decode(%q) error at %d (%q), want %d (%q)",
+					tt.input, corrupt.Offset, corrupt.Char, tt.offset, tt.char)
+			}
+		})
+	}
+}
+
+func TestCorruptInputErrorMessage(t *testing.T) {
+	_, err := Decode("1230")
+	expected := `invalid base58 character '0' at offset 3`
+	if err == nil || err.Error() != expected {
+		t.Errorf("Decode error = %v, want %q", err, expected)
+	}
+}
+
+func TestDecoderCorruptInputOffset(t *testing.T) {
+	// The offset counts the skipped line break
+	_, err := io.ReadAll(NewDecoder(strings.NewReader("jpXCZedGfVQ\n1O")))
+
+	var corrupt *CorruptInputError
+	if !errors.As(err, &corrupt) {
+		t.Fatalf("decode error = %v, want *CorruptInputError", err)
+	}
+	if corrupt.Offset != 13 || corrupt.Char != 'O' {
+		t.Errorf("error at %d (%q), want 13 ('O')", corrupt.Offset, corrupt.Char)
+	}
+}
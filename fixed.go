@@ -0,0 +1,230 @@
+package base58
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// Fixed-size conversion uses the same 64-bit limbs as the generic path, but
+// on stack arrays of known size, so it needs no pooled scratch space and
+// skips limbs that are known to still be zero.
+const (
+	// Maximum encoded lengths of 32 and 64 bytes
+	encoded32Len = 44
+	encoded64Len = 88
+	// Base 58^10 limbs needed to hold the maximum encoded lengths
+	encoded32Limbs = (encoded32Len + limbDigits - 1) / limbDigits
+	encoded64Limbs = (encoded64Len + limbDigits - 1) / limbDigits
+	// Number of 64-bit words in 32 and 64 bytes
+	fixed32Words = 32 / limbBytes
+	fixed64Words = 64 / limbBytes
+	// Five-digit halves of a limb are extracted with 32-bit arithmetic
+	halfLimbBase   = base58 * base58 * base58 * base58 * base58
+	halfLimbDigits = limbDigits / 2
+)
+
+// Encode32 encodes a 32-byte value, such as a public key, with the Bitcoin alphabet
+func Encode32(src [32]byte) string {
+	return BitcoinEncoding.Encode32(src)
+}
+
+// Decode32 decodes a Base58 string of a 32-byte value with the Bitcoin alphabet
+func Decode32(s string) ([32]byte, error) {
+	return BitcoinEncoding.Decode32(s)
+}
+
+// Encode64 encodes a 64-byte value, such as a signature, with the Bitcoin alphabet
+func Encode64(src [64]byte) string {
+	return BitcoinEncoding.Encode64(src)
+}
+
+// Decode64 decodes a Base58 string of a 64-byte value with the Bitcoin alphabet
+func Decode64(s string) ([64]byte, error) {
+	return BitcoinEncoding.Decode64(s)
+}
+
+// Encode32 encodes a 32-byte value using fixed-size arithmetic
+func (enc *Encoding) Encode32(src [32]byte) string {
+	var buf [encoded32Len]byte
+	return string(enc.appendEncode32(buf[:0], &src))
+}
+
+// Encode64 encodes a 64-byte value using fixed-size arithmetic
+func (enc *Encoding) Encode64(src [64]byte) string {
+	var buf [encoded64Len]byte
+	return string(enc.appendEncode64(buf[:0], &src))
+}
+
+// Decode32 decodes a string that encodes exactly 32 bytes. It returns
+// ErrInvalidLength if s decodes to any other length.
+func (enc *Encoding) Decode32(s string) ([32]byte, error) {
+	var dst [32]byte
+	err := enc.decode32(&dst, s)
+	return dst, err
+}
+
+// Decode64 decodes a string that encodes exactly 64 bytes. It returns
+// ErrInvalidLength if s decodes to any other length.
+func (enc *Encoding) Decode64(s string) ([64]byte, error) {
+	var dst [64]byte
+	err := enc.decode64(&dst, s)
+	return dst, err
+}
+
+func (enc *Encoding) appendEncode32(dst []byte, src *[32]byte) []byte {
+	var limbs [encoded32Limbs]uint64
+	divFixedWords(limbs[:], src[:])
+	return enc.appendFixedDigits(dst, src[:], limbs[:])
+}
+
+func (enc *Encoding) appendEncode64(dst []byte, src *[64]byte) []byte {
+	var limbs [encoded64Limbs]uint64
+	divFixedWords(limbs[:], src[:])
+	return enc.appendFixedDigits(dst, src[:], limbs[:])
+}
+
+// divFixedWords converts src, read as big-endian 64-bit words, to big-endian
+// base 58^10 limbs. After w words the value is below 2^(64w), which fits in
+// the lowest 64w/58+1 limbs, so only those are divided.
+func divFixedWords(limbs []uint64, src []byte) {
+	for w := 1; w <= len(src)/limbBytes; w++ {
+		carry := binary.BigEndian.Uint64(src[(w-1)*limbBytes:])
+		low := len(limbs) - w*64/58 - 1
+		if low < 0 {
+			low = 0
+		}
+		for j := len(limbs) - 1; j >= low; j-- {
+			carry, limbs[j] = bits.Div64(limbs[j], carry, limbBase)
+		}
+	}
+}
+
+// appendFixedDigits appends the digits of big-endian base 58^10 limbs to dst,
+// replacing leading zero digits with one zero digit per leading zero byte of src
+func (enc *Encoding) appendFixedDigits(dst, src []byte, limbs []uint64) []byte {
+	var digits [encoded64Limbs * limbDigits]byte
+	raw := digits[:len(limbs)*limbDigits]
+	for i, limb := range limbs {
+		hi := uint32(limb / halfLimbBase)
+		lo := uint32(limb % halfLimbBase)
+		half := raw[i*limbDigits : (i+1)*limbDigits]
+		for k := halfLimbDigits - 1; k >= 0; k-- {
+			half[k] = byte(hi % base58)
+			half[halfLimbDigits+k] = byte(lo % base58)
+			hi /= base58
+			lo /= base58
+		}
+	}
+
+	skip := 0
+	for skip < len(raw) && raw[skip] == 0 {
+		skip++
+	}
+	for i := 0; i < len(src) && src[i] == 0; i++ {
+		dst = append(dst, enc.encode[0])
+	}
+	for _, digit := range raw[skip:] {
+		dst = append(dst, enc.encode[digit])
+	}
+	return dst
+}
+
+func (enc *Encoding) decode32(dst *[32]byte, s string) error {
+	var limbs [encoded32Limbs]uint64
+	if err := enc.fixedLimbs(limbs[:], s, encoded32Len); err != nil {
+		return err
+	}
+
+	var words [fixed32Words]uint64
+	if err := mulFixedLimbs(words[:], limbs[:]); err != nil {
+		return err
+	}
+	for i, word := range words {
+		binary.BigEndian.PutUint64(dst[i*limbBytes:], word)
+	}
+	return enc.checkFixedLeading(dst[:], s)
+}
+
+func (enc *Encoding) decode64(dst *[64]byte, s string) error {
+	var limbs [encoded64Limbs]uint64
+	if err := enc.fixedLimbs(limbs[:], s, encoded64Len); err != nil {
+		return err
+	}
+
+	var words [fixed64Words]uint64
+	if err := mulFixedLimbs(words[:], limbs[:]); err != nil {
+		return err
+	}
+	for i, word := range words {
+		binary.BigEndian.PutUint64(dst[i*limbBytes:], word)
+	}
+	return enc.checkFixedLeading(dst[:], s)
+}
+
+// mulFixedLimbs converts big-endian base 58^10 limbs to big-endian 64-bit
+// words. It returns ErrInvalidLength if the value does not fit in words.
+func mulFixedLimbs(words, limbs []uint64) error {
+	used := 0
+	for _, limb := range limbs {
+		carry := limb
+		for k := len(words) - 1; k >= len(words)-used; k-- {
+			hi, lo := bits.Mul64(words[k], limbBase)
+			lo, c := bits.Add64(lo, carry, 0)
+			words[k], carry = lo, hi+c
+		}
+		if carry != 0 {
+			if used == len(words) {
+				return ErrInvalidLength
+			}
+			used++
+			words[len(words)-used] = carry
+		}
+	}
+	return nil
+}
+
+// fixedLimbs parses s, right-aligned, into big-endian base 58^10 limbs
+func (enc *Encoding) fixedLimbs(limbs []uint64, s string, maxLen int) error {
+	if len(s) > maxLen {
+		if err := enc.Validate(s); err != nil {
+			return err
+		}
+		return ErrInvalidLength
+	}
+
+	// s is right-aligned in the limbs, so the first limbs may be only
+	// partially filled or entirely zero padding
+	pad := len(limbs)*limbDigits - len(s)
+	i := 0
+	for j := range limbs {
+		end := (j+1)*limbDigits - pad
+		var limb uint64
+		for ; i < end; i++ {
+			digit := enc.decodeMap[s[i]]
+			if digit == invalidIndex {
+				return corruptInputError(s, i)
+			}
+			limb = limb*base58 + uint64(digit)
+		}
+		limbs[j] = limb
+	}
+	return nil
+}
+
+// checkFixedLeading verifies that the leading zero digits of s match the
+// leading zero bytes of the decoded value, so that s decodes to exactly
+// len(dst) bytes
+func (enc *Encoding) checkFixedLeading(dst []byte, s string) error {
+	leading := 0
+	for leading < len(s) && s[leading] == enc.encode[0] {
+		leading++
+	}
+	zeros := 0
+	for zeros < len(dst) && dst[zeros] == 0 {
+		zeros++
+	}
+	if leading != zeros {
+		return ErrInvalidLength
+	}
+	return nil
+}
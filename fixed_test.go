@@ -0,0 +1,131 @@
+package base58
+
+import (
+	"bytes"
+	"errors"
+	mrand "math/rand"
+	"strings"
+	"testing"
+)
+
+func TestEncodeDecode32(t *testing.T) {
+	rng := mrand.New(mrand.NewSource(3))
+
+	for i := 0; i < 500; i++ {
+		var key [32]byte
+		rng.Read(key[:])
+		// Vary the number of leading zero bytes, including all zeros
+		for j := 0; j < i%34 && j < len(key); j++ {
+			key[j] = 0
+		}
+
+		expected := referenceEncode(key[:])
+		encoded := Encode32(key)
+		if encoded != expected {
+			t.Fatalf("Encode32(%x) = %q, want %q", key, encoded, expected)
+		}
+
+		decoded, err := Decode32(encoded)
+		if err != nil {
+			t.Fatalf("Decode32(%q) unexpected error: %v", encoded, err)
+		}
+		if decoded != key {
+			t.Fatalf("Decode32(%q) = %x, want %x", encoded, decoded, key)
+		}
+	}
+}
+
+func TestEncodeDecode64(t *testing.T) {
+	rng := mrand.New(mrand.NewSource(4))
+
+	for i := 0; i < 500; i++ {
+		var sig [64]byte
+		rng.Read(sig[:])
+		for j := 0; j < i%66 && j < len(sig); j++ {
+			sig[j] = 0
+		}
+
+		expected := referenceEncode(sig[:])
+		encoded := Encode64(sig)
+		if encoded != expected {
+			t.Fatalf("Encode64(%x) = %q, want %q", sig, encoded, expected)
+		}
+
+		decoded, err := Decode64(encoded)
+		if err != nil {
+			t.Fatalf("Decode64(%q) unexpected error: %v", encoded, err)
+		}
+		if decoded != sig {
+			t.Fatalf("Decode64(%q) = %x, want %x", encoded, decoded, sig)
+		}
+	}
+}
+
+func TestDecode32Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{
+			name:  "empty",
+			input: "",
+			err:   ErrInvalidLength,
+		},
+		{
+			name:  "too short",
+			input: "JxF12TrwUP45BMd",
+			err:   ErrInvalidLength,
+		},
+		{
+			name:  "too long",
+			input: strings.Repeat("2", 45),
+			err:   ErrInvalidLength,
+		},
+		{
+			name:  "overflow",
+			input: strings.Repeat("z", 44),
+			err:   ErrInvalidLength,
+		},
+		{
+			name:  "too many leading ones",
+			input: strings.Repeat("1", 33),
+			err:   ErrInvalidLength,
+		},
+		{
+			name:  "invalid character",
+			input: "4vJ9JU1bJJE96FWSJKvHsmmFADCg4gpZQff4P3bkLKi0",
+			err:   ErrInvalidCharacter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode32(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Decode32(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestDecodeFixedLengthFallback(t *testing.T) {
+	// 44-character strings that decode to 33 bytes and 43-character strings
+	// that decode to 31 bytes must fall back to the generic path
+	inputs := [][]byte{
+		append([]byte{0x01}, bytes.Repeat([]byte{0xFF}, 32)...),
+		bytes.Repeat([]byte{0xFF}, 31),
+		append([]byte{0x01}, bytes.Repeat([]byte{0xFF}, 64)...),
+	}
+
+	for _, input := range inputs {
+		encoded := referenceEncode(input)
+		decoded, err := Decode(encoded)
+		if err != nil {
+			t.Fatalf("Decode(%q) unexpected error: %v", encoded, err)
+		}
+		if !bytes.Equal(decoded, input) {
+			t.Errorf("Decode(%q) = %x, want %x", encoded, decoded, input)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Package blake256 implements the BLAKE-256 hash (the SHA-3 finalist, 14
+// rounds) used by Decred.
+package blake256
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	// Size is the size of a BLAKE-256 checksum in bytes
+	Size = 32
+	// BlockSize is the block size of BLAKE-256 in bytes
+	BlockSize = 64
+)
+
+// rounds is the number of rounds in the final BLAKE-256
+const rounds = 14
+
+var iv = [8]uint32{
+	0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a,
+	0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19,
+}
+
+var constants = [16]uint32{
+	0x243f6a88, 0x85a308d3, 0x13198a2e, 0x03707344,
+	0xa4093822, 0x299f31d0, 0x082efa98, 0xec4e6c89,
+	0x452821e6, 0x38d01377, 0xbe5466cf, 0x34e90c6c,
+	0xc0ac29b7, 0xc97c50dd, 0x3f84d5b5, 0xb5470917,
+}
+
+var sigma = [10][16]uint8{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+}
+
+// Sum256 returns the BLAKE-256 checksum of data
+func Sum256(data []byte) [Size]byte {
+	h := iv
+	bitLen := uint64(len(data)) << 3
+
+	var counter uint64
+	for len(data) >= BlockSize {
+		counter += BlockSize << 3
+		compress(&h, data[:BlockSize], counter)
+		data = data[BlockSize:]
+	}
+
+	// The padding sets a final 1 bit just before the 64-bit length and may
+	// spill into a second block. A block holding no message bits is
+	// compressed with a zero counter.
+	var tail [2 * BlockSize]byte
+	n := copy(tail[:], data)
+	tail[n] = 0x80
+	padded := tail[:BlockSize]
+	if n > BlockSize-9 {
+		padded = tail[:]
+	}
+	padded[len(padded)-9] |= 0x01
+	binary.BigEndian.PutUint64(padded[len(padded)-8:], bitLen)
+
+	switch {
+	case n == 0:
+		compress(&h, padded, 0)
+	case len(padded) == BlockSize:
+		compress(&h, padded, bitLen)
+	default:
+		compress(&h, padded[:BlockSize], bitLen)
+		compress(&h, padded[BlockSize:], 0)
+	}
+
+	var sum [Size]byte
+	for i, v := range h {
+		binary.BigEndian.PutUint32(sum[i*4:], v)
+	}
+	return sum
+}
+
+// compress mixes one block into the state. The salt is always zero, and the
+// counter fits in 64 bits for any input that fits in memory.
+func compress(h *[8]uint32, block []byte, counter uint64) {
+	var m [16]uint32
+	for i := range m {
+		m[i] = binary.BigEndian.Uint32(block[i*4:])
+	}
+
+	var v [16]uint32
+	copy(v[:8], h[:])
+	copy(v[8:], constants[:8])
+	v[12] ^= uint32(counter)
+	v[13] ^= uint32(counter)
+	v[14] ^= uint32(counter >> 32)
+	v[15] ^= uint32(counter >> 32)
+
+	for r := 0; r < rounds; r++ {
+		s := &sigma[r%len(sigma)]
+		mix(&v, &m, s, 0, 4, 8, 12, 0)
+		mix(&v, &m, s, 1, 5, 9, 13, 2)
+		mix(&v, &m, s, 2, 6, 10, 14, 4)
+		mix(&v, &m, s, 3, 7, 11, 15, 6)
+		mix(&v, &m, s, 0, 5, 10, 15, 8)
+		mix(&v, &m, s, 1, 6, 11, 12, 10)
+		mix(&v, &m, s, 2, 7, 8, 13, 12)
+		mix(&v, &m, s, 3, 4, 9, 14, 14)
+	}
+
+	for i := range h {
+		h[i] ^= v[i] ^ v[i+8]
+	}
+}
+
+// mix is the BLAKE-256 G function using message words s[i] and s[i+1]
+func mix(v *[16]uint32, m *[16]uint32, s *[16]uint8, a, b, c, d, i int) {
+	x, y := s[i], s[i+1]
+	v[a] += v[b] + (m[x] ^ constants[y])
+	v[d] = bits.RotateLeft32(v[d]^v[a], -16)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft32(v[b]^v[c], -12)
+	v[a] += v[b] + (m[y] ^ constants[x])
+	v[d] = bits.RotateLeft32(v[d]^v[a], -8)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft32(v[b]^v[c], -7)
+}
@@ -0,0 +1,26 @@
+package blake256
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestSum256(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", "716f6e863f744b9ac22c97ec7b76ea5f5908bc5b2f67c61510bfc4751384ea7a"},
+		{"\x00", "0ce8d4ef4dd7cd8d62dfded9d4edb0a774ae6a41929a74da23109e8f11139c87"},
+		// Padding spills into a second block that carries no message bits
+		{strings.Repeat("\x00", 72), "d419bad32d504fb7d44d460c42c5593fe544fa4c135dec31e21bd9abdcc22d41"},
+	}
+
+	for _, tt := range tests {
+		sum := Sum256([]byte(tt.input))
+		if got := hex.EncodeToString(sum[:]); got != tt.expected {
+			t.Errorf("Sum256(%.20q) = %s, want %s", tt.input, got, tt.expected)
+		}
+	}
+}
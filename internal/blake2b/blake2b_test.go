@@ -0,0 +1,48 @@
+package blake2b
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	tests := []struct {
+		input  string
+		sum512 string
+		sum256 string
+	}{
+		{
+			"",
+			"786a02f742015903c6c6fd852552d272912f4740e15847618a86e217f71f5419d25e1031afee585313896444934eb04b903a685b1448b755d56f701afe9be2ce",
+			"0e5751c026e543b2e8ab2eb06099daa1d1e5df47778f7787faab45cdf12fe3a8",
+		},
+		{
+			"abc",
+			"ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923",
+			"bddd813c634239723171ef3fee98579b94964e3bb1cb3e427262c8c068d52319",
+		},
+		{
+			// Exactly one block, which must be compressed as the final block
+			strings.Repeat("a", BlockSize),
+			"fc6c71f688f43ea7d60817478808f3cac753e61571865c95adbc2d9122c943a76b92c2cb1047ef3fe7bf6e436ec1d0a99a9e5b216780bf7fed9d7ca91d3a8f3b",
+			"ae2aa48507885c4c950fb809b2076f959cde9f8ea6da260d9a3587df33dac450",
+		},
+		{
+			strings.Repeat("a", 300),
+			"a2ff3040eda405b929c2fc2fd93e8add6ac3bb5369b679bae170ac6956863ca006285f132a868000fc3fae5bc696e5d17fe3fddfb4a342876c40451184742986",
+			"3c1292de00a518e36823f9ff908ac2da46be38718c018713403461df077e15f6",
+		},
+	}
+
+	for _, tt := range tests {
+		sum512 := Sum512([]byte(tt.input))
+		if got := hex.EncodeToString(sum512[:]); got != tt.sum512 {
+			t.Errorf("Sum512(%.20q) = %s, want %s", tt.input, got, tt.sum512)
+		}
+		sum256 := Sum256([]byte(tt.input))
+		if got := hex.EncodeToString(sum256[:]); got != tt.sum256 {
+			t.Errorf("Sum256(%.20q) = %s, want %s", tt.input, got, tt.sum256)
+		}
+	}
+}
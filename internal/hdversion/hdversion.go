@@ -0,0 +1,12 @@
+// Package hdversion holds the BIP32 extended key versions shared by the
+// bip32 and network packages. network cannot import bip32, which depends on
+// bitcoin and through it on network, so both take the values from here.
+package hdversion
+
+// Standard BIP32 serialization versions
+const (
+	Xpub uint32 = 0x0488b21e
+	Xprv uint32 = 0x0488ade4
+	Tpub uint32 = 0x043587cf
+	Tprv uint32 = 0x04358394
+)
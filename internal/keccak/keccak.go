@@ -0,0 +1,92 @@
+// Package keccak implements the original Keccak-256 hash used by Monero and
+// Ethereum. It differs from SHA3-256 only in the padding byte.
+package keccak
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// Size is the size of a Keccak-256 checksum in bytes
+const Size = 32
+
+// rate is the Keccak-256 sponge rate in bytes
+const rate = 136
+
+var roundConstants = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808a, 0x8000000080008000,
+	0x000000000000808b, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008a, 0x0000000000000088, 0x0000000080008009, 0x000000008000000a,
+	0x000000008000808b, 0x800000000000008b, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800a, 0x800000008000000a,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// rotations and piLanes drive the combined rho and pi steps
+var (
+	rotations = [24]int{1, 3, 6, 10, 15, 21, 28, 36, 45, 55, 2, 14, 27, 41, 56, 8, 25, 43, 62, 18, 39, 61, 20, 44}
+	piLanes   = [24]int{10, 7, 11, 17, 18, 3, 5, 16, 8, 21, 24, 4, 15, 23, 19, 13, 12, 2, 20, 14, 22, 9, 6, 1}
+)
+
+// Sum256 returns the Keccak-256 checksum of data
+func Sum256(data []byte) [Size]byte {
+	var state [25]uint64
+
+	for len(data) >= rate {
+		absorb(&state, data[:rate])
+		data = data[rate:]
+	}
+
+	var last [rate]byte
+	copy(last[:], data)
+	last[len(data)] = 0x01
+	last[rate-1] |= 0x80
+	absorb(&state, last[:])
+
+	var sum [Size]byte
+	for i := 0; i < Size/8; i++ {
+		binary.LittleEndian.PutUint64(sum[i*8:], state[i])
+	}
+	return sum
+}
+
+func absorb(state *[25]uint64, block []byte) {
+	for i := 0; i < rate/8; i++ {
+		state[i] ^= binary.LittleEndian.Uint64(block[i*8:])
+	}
+	permute(state)
+}
+
+// permute applies the 24-round Keccak-f[1600] permutation
+func permute(a *[25]uint64) {
+	var c [5]uint64
+	for _, rc := range roundConstants {
+		// Theta
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+		for x := 0; x < 5; x++ {
+			d := c[(x+4)%5] ^ bits.RotateLeft64(c[(x+1)%5], 1)
+			for y := 0; y < 25; y += 5 {
+				a[y+x] ^= d
+			}
+		}
+
+		// Rho and pi
+		current := a[1]
+		for i, lane := range piLanes {
+			current, a[lane] = a[lane], bits.RotateLeft64(current, rotations[i])
+		}
+
+		// Chi
+		for y := 0; y < 25; y += 5 {
+			copy(c[:], a[y:y+5])
+			for x := 0; x < 5; x++ {
+				a[y+x] = c[x] ^ (^c[(x+1)%5] & c[(x+2)%5])
+			}
+		}
+
+		// Iota
+		a[0] ^= rc
+	}
+}
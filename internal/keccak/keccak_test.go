@@ -0,0 +1,27 @@
+package keccak
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestSum256(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{"abc", "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45"},
+		{"The quick brown fox jumps over the lazy dog", "4d741b6f1eb29cb2a9b9911c82f56fa8d73b04959d3d9d222895df6c0b28aa15"},
+		// Exactly one block, so padding needs a block of its own
+		{strings.Repeat("a", rate), "a6c4d403279fe3e0af03729caada8374b5ca54d8065329a3ebcaeb4b60aa386e"},
+	}
+
+	for _, tt := range tests {
+		sum := Sum256([]byte(tt.input))
+		if got := hex.EncodeToString(sum[:]); got != tt.expected {
+			t.Errorf("Sum256(%.20q) = %s, want %s", tt.input, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package ripemd160
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", "9c1185a5c5e9fc54612808977ee8f548b2258d31"},
+		{"a", "0bdc9d2d256b3ee9daae347be6f4dc835a467ffe"},
+		{"abc", "8eb208f7e05d987a9b044a8e98c6b087f15a0bfc"},
+		{"message digest", "5d0689ef49d2fae572b881b123a85ffa21595f36"},
+		{"abcdefghijklmnopqrstuvwxyz", "f71c27109c692c1b56bbdceb5b9d2865b3708dbc"},
+		{"abcdbcdecdefdefgefghfghighijhijkijkljklmklmnlmnomnopnopq", "12a053384a9c0c88e405a06c27dcf49ada62eb2b"},
+		{strings.Repeat("1234567890", 8), "9b752e45573d4b39f4dbd3323cab82bf63326bfb"},
+		{strings.Repeat("a", 1000000), "52783243c1697bdbe16d37f97f68f08325dc1528"},
+	}
+
+	for _, tt := range tests {
+		sum := Sum([]byte(tt.input))
+		if got := hex.EncodeToString(sum[:]); got != tt.expected {
+			t.Errorf("Sum(%.20q) = %s, want %s", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestHashWrite(t *testing.T) {
+	input := []byte(strings.Repeat("abcdefghijklmnopqrstuvwxyz", 10))
+	want := Sum(input)
+
+	// Split the input across block boundaries in uneven pieces
+	h := New()
+	for i := 0; i < len(input); i += 7 {
+		end := i + 7
+		if end > len(input) {
+			end = len(input)
+		}
+		h.Write(input[i:end])
+	}
+
+	if got := h.Sum(nil); string(got) != string(want[:]) {
+		t.Errorf("New().Sum() = %x, want %x", got, want)
+	}
+	// Sum must not change the running state
+	if got := h.Sum(nil); string(got) != string(want[:]) {
+		t.Errorf("second Sum() = %x, want %x", got, want)
+	}
+}
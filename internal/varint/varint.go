@@ -0,0 +1,61 @@
+// Package varint implements the unsigned LEB128 varints used by multiformats.
+// Unlike encoding/binary, it rejects non-minimal encodings and values longer
+// than nine bytes as the multiformats specification requires.
+package varint
+
+import "errors"
+
+// MaxLen is the maximum length of a multiformats varint in bytes
+const MaxLen = 9
+
+var (
+	// ErrTruncated is returned when the input ends inside a varint
+	ErrTruncated = errors.New("varint: truncated")
+	// ErrOverflow is returned when a varint is longer than MaxLen bytes
+	ErrOverflow = errors.New("varint: longer than 9 bytes")
+	// ErrNotMinimal is returned when a varint has redundant trailing zero groups
+	ErrNotMinimal = errors.New("varint: not minimally encoded")
+)
+
+// Append appends the varint encoding of v to dst
+func Append(dst []byte, v uint64) []byte {
+	for v >= 0x80 {
+		dst = append(dst, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(dst, byte(v))
+}
+
+// Len returns the number of bytes needed to encode v
+func Len(v uint64) int {
+	n := 1
+	for v >= 0x80 {
+		v >>= 7
+		n++
+	}
+	return n
+}
+
+// Read decodes a varint from the start of b and returns the value and the
+// number of bytes consumed
+func Read(b []byte) (uint64, int, error) {
+	var v uint64
+	for i := 0; i < len(b); i++ {
+		if i == MaxLen {
+			return 0, 0, ErrOverflow
+		}
+
+		c := b[i]
+		v |= uint64(c&0x7f) << (7 * i)
+		if c < 0x80 {
+			if c == 0 && i > 0 {
+				return 0, 0, ErrNotMinimal
+			}
+			return v, i + 1, nil
+		}
+	}
+	if len(b) >= MaxLen {
+		return 0, 0, ErrOverflow
+	}
+	return 0, 0, ErrTruncated
+}
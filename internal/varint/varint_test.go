@@ -0,0 +1,57 @@
+package varint
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		value   uint64
+		encoded []byte
+	}{
+		{0, []byte{0x00}},
+		{1, []byte{0x01}},
+		{0x7f, []byte{0x7f}},
+		{0x80, []byte{0x80, 0x01}},
+		{0xed, []byte{0xed, 0x01}},
+		{0x1200, []byte{0x80, 0x24}},
+		{1<<63 - 1, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f}},
+	}
+
+	for _, tt := range tests {
+		encoded := Append(nil, tt.value)
+		if string(encoded) != string(tt.encoded) {
+			t.Errorf("Append(%d) = %x, want %x", tt.value, encoded, tt.encoded)
+		}
+		if Len(tt.value) != len(tt.encoded) {
+			t.Errorf("Len(%d) = %d, want %d", tt.value, Len(tt.value), len(tt.encoded))
+		}
+
+		value, n, err := Read(append(encoded, 0xaa))
+		if err != nil || value != tt.value || n != len(tt.encoded) {
+			t.Errorf("Read(%x) = %d, %d, %v, want %d, %d", encoded, value, n, err, tt.value, len(tt.encoded))
+		}
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   error
+	}{
+		{"empty", nil, ErrTruncated},
+		{"truncated", []byte{0x80}, ErrTruncated},
+		{"not minimal", []byte{0x81, 0x00}, ErrNotMinimal},
+		{"too long", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := Read(tt.input); !errors.Is(err, tt.err) {
+				t.Errorf("Read(%x) error = %v, want %v", tt.input, err, tt.err)
+			}
+		})
+	}
+}
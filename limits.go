@@ -0,0 +1,61 @@
+package base58
+
+// minDecodedSizeMultiplier bounds log(58)/log(256) ≈ 0.7322 from below, so
+// that the estimated decoded length never exceeds the real one
+const minDecodedSizeMultiplier = 732
+
+// DecodeOptions limits the work done when decoding untrusted input.
+// A zero field means no limit.
+type DecodeOptions struct {
+	// MaxInputLen is the maximum length of the encoded string
+	MaxInputLen int
+	// MaxDecodedLen is the maximum number of decoded bytes
+	MaxDecodedLen int
+}
+
+// DecodeWithOptions decodes s with the Bitcoin alphabet, enforcing the limits in opts
+func DecodeWithOptions(s string, opts DecodeOptions) ([]byte, error) {
+	return BitcoinEncoding.DecodeWithOptions(s, opts)
+}
+
+// DecodeWithOptions decodes s, enforcing the limits in opts. Input that is
+// certain to exceed a limit is rejected with a *LimitError before any
+// arithmetic is done.
+func (enc *Encoding) DecodeWithOptions(s string, opts DecodeOptions) ([]byte, error) {
+	if opts.MaxInputLen > 0 && len(s) > opts.MaxInputLen {
+		return nil, &LimitError{Limit: opts.MaxInputLen, Size: len(s)}
+	}
+
+	if opts.MaxDecodedLen > 0 {
+		if size := enc.minDecodedLen(s); size > opts.MaxDecodedLen {
+			return nil, &LimitError{Limit: opts.MaxDecodedLen, Size: size, Decoded: true}
+		}
+	}
+
+	decoded, err := enc.Decode(s)
+	if err != nil {
+		return nil, err
+	}
+
+	// The estimate is a lower bound, so the exact length is checked as well
+	if opts.MaxDecodedLen > 0 && len(decoded) > opts.MaxDecodedLen {
+		return nil, &LimitError{Limit: opts.MaxDecodedLen, Size: len(decoded), Decoded: true}
+	}
+	return decoded, nil
+}
+
+// minDecodedLen returns a lower bound of the decoded length of s without
+// decoding it. Each leading zero digit is one byte; the remaining n digits
+// start with a nonzero digit, so their value is at least 58^(n-1).
+func (enc *Encoding) minDecodedLen(s string) int {
+	leading := 0
+	for leading < len(s) && s[leading] == enc.encode[0] {
+		leading++
+	}
+
+	rest := len(s) - leading
+	if rest == 0 {
+		return leading
+	}
+	return leading + (rest-1)*minDecodedSizeMultiplier/bufferSizeDivisor + 1
+}
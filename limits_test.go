@@ -0,0 +1,93 @@
+package base58
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDecodeWithOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		opts    DecodeOptions
+		err     error
+		decoded bool
+	}{
+		{
+			name:  "no limits",
+			input: "JxF12TrwUP45BMd",
+			opts:  DecodeOptions{},
+		},
+		{
+			name:  "within limits",
+			input: "JxF12TrwUP45BMd",
+			opts:  DecodeOptions{MaxInputLen: 15, MaxDecodedLen: 11},
+		},
+		{
+			name:  "input too long",
+			input: "JxF12TrwUP45BMd",
+			opts:  DecodeOptions{MaxInputLen: 14},
+			err:   ErrTooLarge,
+		},
+		{
+			name:    "decoded too long",
+			input:   "JxF12TrwUP45BMd",
+			opts:    DecodeOptions{MaxDecodedLen: 10},
+			err:     ErrTooLarge,
+			decoded: true,
+		},
+		{
+			name:    "leading zeros count towards decoded length",
+			input:   "1111",
+			opts:    DecodeOptions{MaxDecodedLen: 3},
+			err:     ErrTooLarge,
+			decoded: true,
+		},
+		{
+			name:  "invalid character within limits",
+			input: "JxF12Trw0P45BMd",
+			opts:  DecodeOptions{MaxDecodedLen: 11},
+			err:   ErrInvalidCharacter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := DecodeWithOptions(tt.input, tt.opts)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("DecodeWithOptions(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+
+			var limit *LimitError
+			if errors.As(err, &limit) && limit.Decoded != tt.decoded {
+				t.Errorf("LimitError.Decoded = %v, want %v", limit.Decoded, tt.decoded)
+			}
+		})
+	}
+}
+
+func TestDecodeWithOptionsRejectsBeforeDecoding(t *testing.T) {
+	// An invalid character at the end shows the input was never decoded
+	input := strings.Repeat("z", 100000) + "0"
+
+	_, err := DecodeWithOptions(input, DecodeOptions{MaxDecodedLen: 1024})
+	if !errors.Is(err, ErrTooLarge) {
+		t.Errorf("DecodeWithOptions error = %v, want ErrTooLarge", err)
+	}
+}
+
+func TestMinDecodedLen(t *testing.T) {
+	for n := 0; n <= 512; n++ {
+		for _, fill := range []string{"1", "2", "z"} {
+			s := strings.Repeat(fill, n)
+			decoded, err := Decode(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if lower := BitcoinEncoding.minDecodedLen(s); lower > len(decoded) {
+				t.Fatalf("minDecodedLen(%d x %s) = %d, exceeds decoded length %d", n, fill, lower, len(decoded))
+			}
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Package monero parses and formats Monero standard, integrated and
+// subaddress addresses, which use block-framed Base58 with a Keccak-256
+// checksum.
+package monero
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jnst/base58"
+	"github.com/jnst/base58/internal/varint"
+)
+
+const (
+	// KeySize is the length of a public spend or view key in bytes
+	KeySize = 32
+	// PaymentIDSize is the length of an integrated address payment ID in bytes
+	PaymentIDSize = 8
+)
+
+// Network identifies a Monero network
+type Network int
+
+const (
+	// Mainnet is the Monero main network
+	Mainnet Network = iota
+	// Testnet is the Monero test network
+	Testnet
+	// Stagenet is the Monero staging network
+	Stagenet
+)
+
+// String returns the network name
+func (n Network) String() string {
+	switch n {
+	case Mainnet:
+		return "mainnet"
+	case Testnet:
+		return "testnet"
+	case Stagenet:
+		return "stagenet"
+	default:
+		return "unknown"
+	}
+}
+
+// AddressType distinguishes the kinds of Monero address
+type AddressType int
+
+const (
+	// Standard is a primary account address
+	Standard AddressType = iota
+	// Integrated is a standard address with an embedded payment ID
+	Integrated
+	// Subaddress is a derived subaddress
+	Subaddress
+)
+
+// String returns the address type name
+func (t AddressType) String() string {
+	switch t {
+	case Standard:
+		return "standard"
+	case Integrated:
+		return "integrated"
+	case Subaddress:
+		return "subaddress"
+	default:
+		return "unknown"
+	}
+}
+
+// prefixes maps each network to its varint address prefixes, indexed by AddressType
+var prefixes = map[Network][3]uint64{
+	Mainnet:  {18, 19, 42},
+	Testnet:  {53, 54, 63},
+	Stagenet: {24, 25, 36},
+}
+
+var (
+	// ErrUnknownPrefix is returned when the address prefix is not a known
+	// network and address type
+	ErrUnknownPrefix = errors.New("monero: unknown address prefix")
+	// ErrInvalidLength is returned when the decoded address has the wrong
+	// length for its type
+	ErrInvalidLength = errors.New("monero: invalid address length")
+)
+
+// Address is a decoded Monero address
+type Address struct {
+	Network  Network
+	Type     AddressType
+	SpendKey [KeySize]byte
+	ViewKey  [KeySize]byte
+	// PaymentID is set only for integrated addresses
+	PaymentID [PaymentIDSize]byte
+}
+
+// ParseAddress decodes a Monero address and verifies its checksum
+func ParseAddress(s string) (Address, error) {
+	_, body, err := base58.CheckDecodeBlocksWith(base58.Keccak256, s, 0)
+	if err != nil {
+		return Address{}, fmt.Errorf("monero: %w", err)
+	}
+
+	prefix, n, err := varint.Read(body)
+	if err != nil {
+		return Address{}, fmt.Errorf("%w: %v", ErrUnknownPrefix, err)
+	}
+	addr, ok := lookupPrefix(prefix)
+	if !ok {
+		return Address{}, fmt.Errorf("%w %d", ErrUnknownPrefix, prefix)
+	}
+
+	body = body[n:]
+	if len(body) != addr.payloadLen() {
+		return Address{}, ErrInvalidLength
+	}
+	copy(addr.SpendKey[:], body)
+	copy(addr.ViewKey[:], body[KeySize:])
+	if addr.Type == Integrated {
+		copy(addr.PaymentID[:], body[2*KeySize:])
+	}
+	return addr, nil
+}
+
+// String returns the Base58 encoding of the address
+func (a Address) String() string {
+	p, ok := prefixes[a.Network]
+	if !ok || a.Type < Standard || a.Type > Subaddress {
+		return ""
+	}
+	prefix := p[a.Type]
+
+	payload := make([]byte, 0, a.payloadLen())
+	payload = append(payload, a.SpendKey[:]...)
+	payload = append(payload, a.ViewKey[:]...)
+	if a.Type == Integrated {
+		payload = append(payload, a.PaymentID[:]...)
+	}
+
+	return base58.CheckEncodeBlocksWith(base58.Keccak256, varint.Append(nil, prefix), payload)
+}
+
+// payloadLen returns the number of key and payment ID bytes for the address type
+func (a Address) payloadLen() int {
+	if a.Type == Integrated {
+		return 2*KeySize + PaymentIDSize
+	}
+	return 2 * KeySize
+}
+
+// lookupPrefix returns an address with the network and type of prefix set
+func lookupPrefix(prefix uint64) (Address, bool) {
+	for net, p := range prefixes {
+		for typ, v := range p {
+			if v == prefix {
+				return Address{Network: net, Type: AddressType(typ)}, true
+			}
+		}
+	}
+	return Address{}, false
+}
@@ -0,0 +1,109 @@
+package monero
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jnst/base58"
+)
+
+const donation = "44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A"
+
+func TestParseAddress(t *testing.T) {
+	addr, err := ParseAddress(donation)
+	if err != nil {
+		t.Fatalf("ParseAddress() error = %v", err)
+	}
+	if addr.Network != Mainnet || addr.Type != Standard {
+		t.Errorf("ParseAddress() = %v %v, want mainnet standard", addr.Network, addr.Type)
+	}
+	if got := hex.EncodeToString(addr.SpendKey[:]); got != "42f18fc61586554095b0799b5c4b6f00cdeb26a93b20540d366932c6001617b7" {
+		t.Errorf("SpendKey = %s", got)
+	}
+	if got := hex.EncodeToString(addr.ViewKey[:]); got != "5db35109fbba7d5f275fef4b9c49e0cc1c84b219ec6ff652fda54f89f7f63c88" {
+		t.Errorf("ViewKey = %s", got)
+	}
+	if addr.String() != donation {
+		t.Errorf("String() = %q, want %q", addr.String(), donation)
+	}
+}
+
+func TestAddressTypes(t *testing.T) {
+	base, err := ParseAddress(donation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		network Network
+		typ     AddressType
+		prefix  string
+		length  int
+	}{
+		{Mainnet, Standard, "4", 95},
+		{Mainnet, Integrated, "4", 106},
+		{Mainnet, Subaddress, "8", 95},
+		{Testnet, Standard, "9", 95},
+		{Testnet, Integrated, "A", 106},
+		{Testnet, Subaddress, "B", 95},
+		{Stagenet, Standard, "5", 95},
+		{Stagenet, Integrated, "5", 106},
+		{Stagenet, Subaddress, "7", 95},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.network.String()+" "+tt.typ.String(), func(t *testing.T) {
+			addr := base
+			addr.Network = tt.network
+			addr.Type = tt.typ
+			if tt.typ == Integrated {
+				addr.PaymentID = [PaymentIDSize]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0}
+			}
+
+			s := addr.String()
+			if !strings.HasPrefix(s, tt.prefix) || len(s) != tt.length {
+				t.Errorf("String() = %q, want prefix %q and length %d", s, tt.prefix, tt.length)
+			}
+
+			parsed, err := ParseAddress(s)
+			if err != nil {
+				t.Fatalf("ParseAddress(%q) error = %v", s, err)
+			}
+			if parsed != addr {
+				t.Errorf("ParseAddress(%q) = %+v, want %+v", s, parsed, addr)
+			}
+		})
+	}
+}
+
+func TestParseAddressErrors(t *testing.T) {
+	// Change one character inside the last full block so the data still
+	// decodes but the checksum no longer matches
+	corrupted := []byte(donation)
+	corrupted[80] = '2'
+
+	tests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{"bad checksum", string(corrupted), base58.ErrChecksum},
+		{"invalid character", donation[:94] + "0", base58.ErrInvalidCharacter},
+		{"truncated", donation[:93], base58.ErrInvalidBlock},
+		{"whole-number base58", base58.Encode([]byte("not a monero address")), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseAddress(tt.input)
+			if err == nil {
+				t.Fatalf("ParseAddress(%q) succeeded", tt.input)
+			}
+			if tt.err != nil && !errors.Is(err, tt.err) {
+				t.Errorf("ParseAddress(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package base58
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Multibase prefixes for the Base58 alphabets
+const (
+	MultibaseBitcoin = 'z'
+	MultibaseFlickr  = 'Z'
+)
+
+// multibaseNames names common non-Base58 prefixes for clearer errors
+var multibaseNames = map[byte]string{
+	'f': "base16",
+	'F': "base16upper",
+	'b': "base32",
+	'B': "base32upper",
+	'k': "base36",
+	'm': "base64",
+	'u': "base64url",
+	'0': "base2",
+	'7': "base8",
+	'9': "base10",
+}
+
+// MultibaseEncode encodes data as base58btc with the 'z' multibase prefix
+func MultibaseEncode(data []byte) string {
+	return BitcoinEncoding.multibaseEncode(MultibaseBitcoin, data)
+}
+
+// MultibaseDecode decodes a multibase string with the 'z' (Bitcoin alphabet)
+// or 'Z' (Flickr alphabet) prefix
+func MultibaseDecode(s string) ([]byte, error) {
+	return MultibaseDecodeWithOptions(s, DecodeOptions{})
+}
+
+// MultibaseDecodeWithOptions is like MultibaseDecode but enforces the limits
+// in opts. MaxInputLen applies to the Base58 text after the prefix.
+func MultibaseDecodeWithOptions(s string, opts DecodeOptions) ([]byte, error) {
+	if s == "" {
+		return nil, fmt.Errorf("%w: empty input", ErrUnsupportedMultibase)
+	}
+
+	var enc *Encoding
+	switch s[0] {
+	case MultibaseBitcoin:
+		enc = BitcoinEncoding
+	case MultibaseFlickr:
+		enc = FlickrEncoding
+	default:
+		if name, ok := multibaseNames[s[0]]; ok {
+			return nil, fmt.Errorf("%w %q (%s): only 'z' (base58btc) and 'Z' (base58flickr) are supported", ErrUnsupportedMultibase, s[0], name)
+		}
+		return nil, fmt.Errorf("%w %q: only 'z' (base58btc) and 'Z' (base58flickr) are supported", ErrUnsupportedMultibase, s[0])
+	}
+
+	decoded, err := enc.DecodeWithOptions(s[1:], opts)
+	if err != nil {
+		// Report offsets relative to the full multibase string
+		var corrupt *CorruptInputError
+		if errors.As(err, &corrupt) {
+			return nil, corruptInputError(s, corrupt.Offset+1)
+		}
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// MultibaseEncode encodes data with the multibase prefix of the alphabet.
+// Only the Bitcoin and Flickr alphabets have multibase prefixes; other
+// alphabets return ErrUnsupportedMultibase.
+func (enc *Encoding) MultibaseEncode(data []byte) (string, error) {
+	switch enc.encode {
+	case BitcoinEncoding.encode:
+		return enc.multibaseEncode(MultibaseBitcoin, data), nil
+	case FlickrEncoding.encode:
+		return enc.multibaseEncode(MultibaseFlickr, data), nil
+	default:
+		return "", fmt.Errorf("%w: alphabet has no multibase code", ErrUnsupportedMultibase)
+	}
+}
+
+func (enc *Encoding) multibaseEncode(prefix byte, data []byte) string {
+	dst := make([]byte, 0, 1+EncodedLen(len(data)))
+	dst = append(dst, prefix)
+	return string(enc.AppendEncode(dst, data))
+}
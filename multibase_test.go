@@ -0,0 +1,89 @@
+package base58
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMultibase(t *testing.T) {
+	data := []byte("Hello World")
+
+	encoded := MultibaseEncode(data)
+	if encoded != "zJxF12TrwUP45BMd" {
+		t.Errorf("MultibaseEncode() = %q, want %q", encoded, "zJxF12TrwUP45BMd")
+	}
+
+	flickr, err := FlickrEncoding.MultibaseEncode(data)
+	if err != nil {
+		t.Fatalf("FlickrEncoding.MultibaseEncode() error = %v", err)
+	}
+	if flickr != "Z"+FlickrEncoding.Encode(data) {
+		t.Errorf("FlickrEncoding.MultibaseEncode() = %q", flickr)
+	}
+
+	for _, s := range []string{encoded, flickr} {
+		decoded, err := MultibaseDecode(s)
+		if err != nil {
+			t.Fatalf("MultibaseDecode(%q) error = %v", s, err)
+		}
+		if string(decoded) != string(data) {
+			t.Errorf("MultibaseDecode(%q) = %q, want %q", s, decoded, data)
+		}
+	}
+
+	if _, err := RippleEncoding.MultibaseEncode(data); !errors.Is(err, ErrUnsupportedMultibase) {
+		t.Errorf("RippleEncoding.MultibaseEncode() error = %v, want %v", err, ErrUnsupportedMultibase)
+	}
+}
+
+func TestMultibaseDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		err     error
+		message string
+	}{
+		{"empty", "", ErrUnsupportedMultibase, "empty input"},
+		{"base32", "bjbswy3dp", ErrUnsupportedMultibase, "base32"},
+		{"unknown prefix", "?abc", ErrUnsupportedMultibase, "'?'"},
+		{"missing prefix", "JxF12TrwUP45BMd", ErrUnsupportedMultibase, "'J'"},
+		{"invalid character", "zJxF12Trw0P45BMd", ErrInvalidCharacter, "offset 9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := MultibaseDecode(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("MultibaseDecode(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+			if !strings.Contains(err.Error(), tt.message) {
+				t.Errorf("MultibaseDecode(%q) error = %q, want it to mention %q", tt.input, err, tt.message)
+			}
+		})
+	}
+}
+
+func TestMultibaseDecodeWithOptions(t *testing.T) {
+	s := MultibaseEncode([]byte("hello world"))
+
+	decoded, err := MultibaseDecodeWithOptions(s, DecodeOptions{MaxInputLen: len(s) - 1})
+	if err != nil {
+		t.Fatalf("MultibaseDecodeWithOptions(%q) error = %v", s, err)
+	}
+	if string(decoded) != "hello world" {
+		t.Errorf("MultibaseDecodeWithOptions(%q) = %q, want %q", s, decoded, "hello world")
+	}
+
+	_, err = MultibaseDecodeWithOptions(s, DecodeOptions{MaxDecodedLen: 4})
+	var limit *LimitError
+	if !errors.As(err, &limit) {
+		t.Errorf("MultibaseDecodeWithOptions(%q) error = %v, want *LimitError", s, err)
+	}
+
+	_, err = MultibaseDecodeWithOptions("zJxF12Trw0P45BMd", DecodeOptions{MaxInputLen: 64})
+	var corrupt *CorruptInputError
+	if !errors.As(err, &corrupt) || corrupt.Offset != 9 {
+		t.Errorf("MultibaseDecodeWithOptions error = %v, want corrupt input at offset 9", err)
+	}
+}
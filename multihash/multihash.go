@@ -0,0 +1,129 @@
+// Package multihash encodes and decodes self-describing multihash digests
+// and their Base58 string form.
+package multihash
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+
+	"github.com/jnst/base58"
+	"github.com/jnst/base58/internal/varint"
+)
+
+// Multihash function codes
+const (
+	Identity uint64 = 0x00
+	SHA1     uint64 = 0x11
+	SHA2_256 uint64 = 0x12
+	SHA2_512 uint64 = 0x13
+)
+
+// names maps the supported function codes to their multicodec names
+var names = map[uint64]string{
+	Identity: "identity",
+	SHA1:     "sha1",
+	SHA2_256: "sha2-256",
+	SHA2_512: "sha2-512",
+}
+
+var (
+	// ErrUnknownCode is returned when summing with an unsupported function
+	ErrUnknownCode = errors.New("multihash: unknown function code")
+	// ErrLengthMismatch is returned when the digest length does not match the header
+	ErrLengthMismatch = errors.New("multihash: digest length does not match header")
+)
+
+// Multihash is a binary multihash: varint code, varint length and digest
+type Multihash []byte
+
+// Decoded is the parsed form of a multihash
+type Decoded struct {
+	Code   uint64
+	Name   string
+	Length int
+	Digest []byte
+}
+
+// Encode builds a multihash from an existing digest
+func Encode(digest []byte, code uint64) Multihash {
+	buf := make([]byte, 0, varint.Len(code)+varint.Len(uint64(len(digest)))+len(digest))
+	buf = varint.Append(buf, code)
+	buf = varint.Append(buf, uint64(len(digest)))
+	return append(buf, digest...)
+}
+
+// Sum hashes data with the given function and returns the multihash
+func Sum(data []byte, code uint64) (Multihash, error) {
+	switch code {
+	case Identity:
+		return Encode(data, code), nil
+	case SHA1:
+		sum := sha1.Sum(data)
+		return Encode(sum[:], code), nil
+	case SHA2_256:
+		sum := sha256.Sum256(data)
+		return Encode(sum[:], code), nil
+	case SHA2_512:
+		sum := sha512.Sum512(data)
+		return Encode(sum[:], code), nil
+	default:
+		return nil, fmt.Errorf("%w 0x%x", ErrUnknownCode, code)
+	}
+}
+
+// Decode parses a binary multihash. Codes outside the built-in table are
+// accepted and reported with an empty name.
+func Decode(buf []byte) (*Decoded, error) {
+	code, n, err := varint.Read(buf)
+	if err != nil {
+		return nil, fmt.Errorf("multihash: code: %w", err)
+	}
+	buf = buf[n:]
+
+	length, n, err := varint.Read(buf)
+	if err != nil {
+		return nil, fmt.Errorf("multihash: length: %w", err)
+	}
+	buf = buf[n:]
+
+	if uint64(len(buf)) != length {
+		return nil, ErrLengthMismatch
+	}
+
+	return &Decoded{
+		Code:   code,
+		Name:   names[code],
+		Length: int(length),
+		Digest: buf,
+	}, nil
+}
+
+// Cast validates buf as a multihash
+func Cast(buf []byte) (Multihash, error) {
+	if _, err := Decode(buf); err != nil {
+		return nil, err
+	}
+	return Multihash(buf), nil
+}
+
+// FromB58String decodes and validates a Base58 multihash
+func FromB58String(s string) (Multihash, error) {
+	buf, err := base58.Decode(s)
+	if err != nil {
+		return nil, fmt.Errorf("multihash: %w", err)
+	}
+	return Cast(buf)
+}
+
+// B58String returns the Base58 encoding of the multihash
+func (m Multihash) B58String() string {
+	return base58.Encode(m)
+}
+
+// Name returns the multicodec name of a function code, or "" if unknown
+func Name(code uint64) string {
+	return names[code]
+}
@@ -0,0 +1,86 @@
+package multihash
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/jnst/base58/internal/varint"
+)
+
+func TestSum(t *testing.T) {
+	tests := []struct {
+		code     uint64
+		input    string
+		expected string
+	}{
+		{Identity, "foo", "0003666f6f"},
+		{SHA1, "foo", "11140beec7b5ea3f0fdbc95d0dd47f3c5bc275da8a33"},
+		{SHA2_256, "foo", "12202c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"},
+	}
+
+	for _, tt := range tests {
+		t.Run(Name(tt.code), func(t *testing.T) {
+			mh, err := Sum([]byte(tt.input), tt.code)
+			if err != nil {
+				t.Fatalf("Sum() error = %v", err)
+			}
+			if got := hex.EncodeToString(mh); got != tt.expected {
+				t.Errorf("Sum() = %s, want %s", got, tt.expected)
+			}
+
+			decoded, err := Decode(mh)
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if decoded.Code != tt.code || decoded.Length != len(decoded.Digest) || decoded.Name != Name(tt.code) {
+				t.Errorf("Decode() = %+v", decoded)
+			}
+		})
+	}
+
+	if _, err := Sum(nil, 0x1b); !errors.Is(err, ErrUnknownCode) {
+		t.Errorf("Sum(keccak-256) error = %v, want %v", err, ErrUnknownCode)
+	}
+}
+
+func TestB58String(t *testing.T) {
+	const s = "QmPfjpVaf593UQJ9a5ECvdh2x17XuJYG5Yanv5UFnH3jPE"
+
+	mh, err := FromB58String(s)
+	if err != nil {
+		t.Fatalf("FromB58String() error = %v", err)
+	}
+	decoded, err := Decode(mh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Code != SHA2_256 || decoded.Length != 32 {
+		t.Errorf("Decode() = %+v, want sha2-256 with 32-byte digest", decoded)
+	}
+	if mh.B58String() != s {
+		t.Errorf("B58String() = %q, want %q", mh.B58String(), s)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		err   error
+	}{
+		{"empty", nil, varint.ErrTruncated},
+		{"missing length", []byte{0x12}, varint.ErrTruncated},
+		{"short digest", []byte{0x12, 0x20, 0x00}, ErrLengthMismatch},
+		{"long digest", []byte{0x00, 0x01, 0x00, 0x00}, ErrLengthMismatch},
+		{"non-minimal code", []byte{0x92, 0x00, 0x00}, varint.ErrNotMinimal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(tt.input); !errors.Is(err, tt.err) {
+				t.Errorf("Decode(%x) error = %v, want %v", tt.input, err, tt.err)
+			}
+		})
+	}
+}
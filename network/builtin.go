@@ -0,0 +1,81 @@
+package network
+
+import "github.com/jnst/base58/internal/hdversion"
+
+// builtin lists the networks registered at startup. The bitcoin entries are
+// also the version bytes the bitcoin package uses.
+var builtin = []Params{
+	{
+		Name:       "bitcoin",
+		PubKeyHash: []byte{0x00},
+		ScriptHash: []byte{0x05},
+		PrivateKey: []byte{0x80},
+		HDPublic:   hdversion.Xpub,
+		HDPrivate:  hdversion.Xprv,
+	},
+	{
+		Name:       "bitcoin-testnet",
+		PubKeyHash: []byte{0x6f},
+		ScriptHash: []byte{0xc4},
+		PrivateKey: []byte{0xef},
+		HDPublic:   hdversion.Tpub,
+		HDPrivate:  hdversion.Tprv,
+	},
+	{
+		Name:       "bitcoin-regtest",
+		PubKeyHash: []byte{0x6f},
+		ScriptHash: []byte{0xc4},
+		PrivateKey: []byte{0xef},
+		HDPublic:   hdversion.Tpub,
+		HDPrivate:  hdversion.Tprv,
+	},
+	{
+		// Legacy (pre-CashAddr) format, identical to Bitcoin
+		Name:       "bitcoin-cash",
+		PubKeyHash: []byte{0x00},
+		ScriptHash: []byte{0x05},
+		PrivateKey: []byte{0x80},
+		HDPublic:   hdversion.Xpub,
+		HDPrivate:  hdversion.Xprv,
+	},
+	{
+		Name:       "litecoin",
+		PubKeyHash: []byte{0x30},
+		ScriptHash: []byte{0x32},
+		PrivateKey: []byte{0xb0},
+		HDPublic:   hdversion.Xpub,
+		HDPrivate:  hdversion.Xprv,
+	},
+	{
+		Name:       "litecoin-testnet",
+		PubKeyHash: []byte{0x6f},
+		ScriptHash: []byte{0x3a},
+		PrivateKey: []byte{0xef},
+		HDPublic:   hdversion.Tpub,
+		HDPrivate:  hdversion.Tprv,
+	},
+	{
+		Name:       "dogecoin",
+		PubKeyHash: []byte{0x1e},
+		ScriptHash: []byte{0x16},
+		PrivateKey: []byte{0x9e},
+		HDPublic:   0x02facafd,
+		HDPrivate:  0x02fac398,
+	},
+	{
+		Name:       "dash",
+		PubKeyHash: []byte{0x4c},
+		ScriptHash: []byte{0x10},
+		PrivateKey: []byte{0xcc},
+		HDPublic:   hdversion.Xpub,
+		HDPrivate:  hdversion.Xprv,
+	},
+	{
+		Name:       "zcash",
+		PubKeyHash: []byte{0x1c, 0xb8},
+		ScriptHash: []byte{0x1c, 0xbd},
+		PrivateKey: []byte{0x80},
+		HDPublic:   hdversion.Xpub,
+		HDPrivate:  hdversion.Xprv,
+	},
+}
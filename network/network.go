@@ -0,0 +1,250 @@
+// Package network is a registry of Base58Check version bytes for
+// Bitcoin-derived chains. It identifies which networks an encoded address,
+// WIF key or extended key may belong to.
+package network
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/jnst/base58"
+)
+
+var (
+	// ErrDuplicateNetwork is returned when registering a name that is already taken
+	ErrDuplicateNetwork = errors.New("network: already registered")
+	// ErrInvalidParams is returned when registering incomplete parameters
+	ErrInvalidParams = errors.New("network: invalid parameters")
+	// ErrUnknownNetwork is returned when no network matches
+	ErrUnknownNetwork = errors.New("network: no matching network")
+	// ErrInvalidPayload is returned when a payload has the wrong length for its kind
+	ErrInvalidPayload = errors.New("network: invalid payload length")
+)
+
+// Params holds the version bytes a network uses for Base58Check data.
+// Address and WIF versions may be longer than one byte, as on Zcash.
+type Params struct {
+	Name       string
+	PubKeyHash []byte
+	ScriptHash []byte
+	PrivateKey []byte
+	HDPublic   uint32
+	HDPrivate  uint32
+}
+
+// Kind is the type of data a version identifies
+type Kind int
+
+const (
+	// PubKeyHash is a P2PKH address
+	PubKeyHash Kind = iota
+	// ScriptHash is a P2SH address
+	ScriptHash
+	// PrivateKey is a WIF private key
+	PrivateKey
+	// HDPublic is an extended public key
+	HDPublic
+	// HDPrivate is an extended private key
+	HDPrivate
+)
+
+// String returns the kind name
+func (k Kind) String() string {
+	switch k {
+	case PubKeyHash:
+		return "p2pkh"
+	case ScriptHash:
+		return "p2sh"
+	case PrivateKey:
+		return "wif"
+	case HDPublic:
+		return "extended public key"
+	case HDPrivate:
+		return "extended private key"
+	default:
+		return "unknown"
+	}
+}
+
+const (
+	hashLen       = 20
+	privateKeyLen = 32
+	hdVersionLen  = 4
+	hdPayloadLen  = 74
+)
+
+// Match is a network that an encoded string may belong to
+type Match struct {
+	Network Params
+	Kind    Kind
+	// Payload is the data following the version bytes, without checksum
+	Payload []byte
+}
+
+var (
+	mu       sync.RWMutex
+	registry = map[string]Params{}
+)
+
+func init() {
+	for _, p := range builtin {
+		if err := Register(p); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Register adds a network to the registry. Names must be unique and at
+// least one address version must be set.
+func Register(p Params) error {
+	if p.Name == "" || (len(p.PubKeyHash) == 0 && len(p.ScriptHash) == 0) {
+		return ErrInvalidParams
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if _, ok := registry[p.Name]; ok {
+		return fmt.Errorf("%w: %q", ErrDuplicateNetwork, p.Name)
+	}
+	registry[p.Name] = p.clone()
+	return nil
+}
+
+// Lookup returns the parameters of a registered network
+func Lookup(name string) (Params, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	p, ok := registry[name]
+	return p.clone(), ok
+}
+
+// Networks returns all registered networks sorted by name
+func Networks() []Params {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	networks := make([]Params, 0, len(registry))
+	for _, p := range registry {
+		networks = append(networks, p.clone())
+	}
+	sort.Slice(networks, func(i, j int) bool {
+		return networks[i].Name < networks[j].Name
+	})
+	return networks
+}
+
+// Identify decodes a Base58Check string and returns every registered
+// network whose version bytes and payload length match, sorted by name.
+// Several networks often share versions, such as Bitcoin and Bitcoin Cash.
+func Identify(s string) ([]Match, error) {
+	_, data, err := base58.BitcoinEncoding.CheckDecode(s, 0)
+	if err != nil {
+		return nil, fmt.Errorf("network: %w", err)
+	}
+
+	var matches []Match
+	for _, p := range Networks() {
+		if m, ok := p.match(data); ok {
+			matches = append(matches, m)
+		}
+	}
+	if len(matches) == 0 {
+		return nil, ErrUnknownNetwork
+	}
+	return matches, nil
+}
+
+// match reports whether data carries one of the network's versions
+func (p Params) match(data []byte) (Match, bool) {
+	versions := []struct {
+		kind    Kind
+		version []byte
+	}{
+		{PubKeyHash, p.PubKeyHash},
+		{ScriptHash, p.ScriptHash},
+		{PrivateKey, p.PrivateKey},
+		{HDPublic, hdVersion(p.HDPublic)},
+		{HDPrivate, hdVersion(p.HDPrivate)},
+	}
+
+	for _, v := range versions {
+		if len(v.version) == 0 || !bytes.HasPrefix(data, v.version) {
+			continue
+		}
+		payload := data[len(v.version):]
+		if validPayload(v.kind, payload) {
+			return Match{Network: p, Kind: v.kind, Payload: payload}, true
+		}
+	}
+	return Match{}, false
+}
+
+// Encode returns the Base58Check encoding of payload with the network's
+// version for kind
+func (p Params) Encode(kind Kind, payload []byte) (string, error) {
+	version := p.version(kind)
+	if len(version) == 0 {
+		return "", fmt.Errorf("%w: %s has no %s version", ErrInvalidParams, p.Name, kind)
+	}
+	if !validPayload(kind, payload) {
+		return "", ErrInvalidPayload
+	}
+	return base58.CheckEncode(version, payload), nil
+}
+
+// version returns the version bytes for kind
+func (p Params) version(kind Kind) []byte {
+	switch kind {
+	case PubKeyHash:
+		return p.PubKeyHash
+	case ScriptHash:
+		return p.ScriptHash
+	case PrivateKey:
+		return p.PrivateKey
+	case HDPublic:
+		return hdVersion(p.HDPublic)
+	case HDPrivate:
+		return hdVersion(p.HDPrivate)
+	default:
+		return nil
+	}
+}
+
+// validPayload checks the payload length expected for kind
+func validPayload(kind Kind, payload []byte) bool {
+	switch kind {
+	case PubKeyHash, ScriptHash:
+		return len(payload) == hashLen
+	case PrivateKey:
+		return len(payload) == privateKeyLen ||
+			(len(payload) == privateKeyLen+1 && payload[privateKeyLen] == 0x01)
+	case HDPublic, HDPrivate:
+		return len(payload) == hdPayloadLen
+	default:
+		return false
+	}
+}
+
+// hdVersion returns the big-endian extended key version, or nil if unset
+func hdVersion(v uint32) []byte {
+	if v == 0 {
+		return nil
+	}
+	b := make([]byte, hdVersionLen)
+	binary.BigEndian.PutUint32(b, v)
+	return b
+}
+
+// clone copies the version slices so callers cannot modify the registry
+func (p Params) clone() Params {
+	p.PubKeyHash = append([]byte(nil), p.PubKeyHash...)
+	p.ScriptHash = append([]byte(nil), p.ScriptHash...)
+	p.PrivateKey = append([]byte(nil), p.PrivateKey...)
+	return p
+}
@@ -0,0 +1,144 @@
+package network
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func names(matches []Match) []string {
+	var result []string
+	for _, m := range matches {
+		result = append(result, m.Network.Name)
+	}
+	return result
+}
+
+func TestIdentify(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		kind     Kind
+		networks string
+	}{
+		{"bitcoin p2pkh", "1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH", PubKeyHash, "bitcoin,bitcoin-cash"},
+		{"testnet p2pkh", "mrX9vMRYLfVy1BnZbc5gZjuyaqH3ZW2ZHz", PubKeyHash, "bitcoin-regtest,bitcoin-testnet,litecoin-testnet"},
+		{"bitcoin wif", "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn", PrivateKey, "bitcoin,bitcoin-cash,zcash"},
+		{"xpub", "xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8", HDPublic, "bitcoin,bitcoin-cash,dash,litecoin,zcash"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := Identify(tt.input)
+			if err != nil {
+				t.Fatalf("Identify() error = %v", err)
+			}
+			if got := strings.Join(names(matches), ","); got != tt.networks {
+				t.Errorf("Identify() networks = %s, want %s", got, tt.networks)
+			}
+			for _, m := range matches {
+				if m.Kind != tt.kind {
+					t.Errorf("%s kind = %v, want %v", m.Network.Name, m.Kind, tt.kind)
+				}
+			}
+		})
+	}
+}
+
+func TestEncodePrefixes(t *testing.T) {
+	hash := make([]byte, hashLen)
+
+	tests := []struct {
+		network string
+		kind    Kind
+		prefix  string
+	}{
+		{"litecoin", PubKeyHash, "L"},
+		{"litecoin", ScriptHash, "M"},
+		{"dogecoin", PubKeyHash, "D"},
+		{"dogecoin", ScriptHash, "9"},
+		{"dash", PubKeyHash, "X"},
+		{"dash", ScriptHash, "7"},
+		{"zcash", PubKeyHash, "t1"},
+		{"zcash", ScriptHash, "t3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.network+" "+tt.kind.String(), func(t *testing.T) {
+			p, ok := Lookup(tt.network)
+			if !ok {
+				t.Fatalf("Lookup(%q) not found", tt.network)
+			}
+			encoded, err := p.Encode(tt.kind, hash)
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			if !strings.HasPrefix(encoded, tt.prefix) {
+				t.Errorf("Encode() = %q, want prefix %q", encoded, tt.prefix)
+			}
+
+			matches, err := Identify(encoded)
+			if err != nil {
+				t.Fatalf("Identify(%q) error = %v", encoded, err)
+			}
+			found := false
+			for _, m := range matches {
+				found = found || (m.Network.Name == tt.network && m.Kind == tt.kind)
+			}
+			if !found {
+				t.Errorf("Identify(%q) = %v, want %s", encoded, names(matches), tt.network)
+			}
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	custom := Params{
+		Name:       "example-chain",
+		PubKeyHash: []byte{0x41},
+		ScriptHash: []byte{0x42},
+	}
+	if err := Register(custom); err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+	t.Cleanup(func() {
+		mu.Lock()
+		delete(registry, custom.Name)
+		mu.Unlock()
+	})
+
+	encoded, err := custom.Encode(PubKeyHash, make([]byte, hashLen))
+	if err != nil {
+		t.Fatal(err)
+	}
+	matches, err := Identify(encoded)
+	if err != nil || len(matches) != 1 || matches[0].Network.Name != custom.Name {
+		t.Errorf("Identify(%q) = %v, %v, want %s", encoded, names(matches), err, custom.Name)
+	}
+
+	// The registry keeps its own copy of the version bytes
+	custom.PubKeyHash[0] = 0x00
+	if p, _ := Lookup(custom.Name); p.PubKeyHash[0] != 0x41 {
+		t.Errorf("registry PubKeyHash changed to %x", p.PubKeyHash)
+	}
+
+	if err := Register(Params{Name: "bitcoin", PubKeyHash: []byte{0x01}}); !errors.Is(err, ErrDuplicateNetwork) {
+		t.Errorf("Register(duplicate) error = %v, want %v", err, ErrDuplicateNetwork)
+	}
+	if err := Register(Params{Name: "empty"}); !errors.Is(err, ErrInvalidParams) {
+		t.Errorf("Register(empty) error = %v, want %v", err, ErrInvalidParams)
+	}
+}
+
+func TestIdentifyErrors(t *testing.T) {
+	bitcoin, _ := Lookup("bitcoin")
+
+	if _, err := bitcoin.Encode(PubKeyHash, make([]byte, 19)); !errors.Is(err, ErrInvalidPayload) {
+		t.Errorf("Encode(short) error = %v, want %v", err, ErrInvalidPayload)
+	}
+	unknown := Params{Name: "unknown", PubKeyHash: []byte{0xff, 0xfe, 0xfd}}
+	encoded, _ := unknown.Encode(PubKeyHash, make([]byte, hashLen))
+	if _, err := Identify(encoded); !errors.Is(err, ErrUnknownNetwork) {
+		t.Errorf("Identify(unregistered) error = %v, want %v", err, ErrUnknownNetwork)
+	}
+}
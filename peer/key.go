@@ -0,0 +1,80 @@
+package peer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jnst/base58/internal/varint"
+)
+
+// KeyType is the libp2p public key algorithm
+type KeyType uint64
+
+// Key types from the libp2p crypto protobuf
+const (
+	RSA       KeyType = 0
+	Ed25519   KeyType = 1
+	Secp256k1 KeyType = 2
+	ECDSA     KeyType = 3
+)
+
+// Protobuf tags for the PublicKey message fields
+const (
+	tagType = 0x08 // field 1, varint
+	tagData = 0x12 // field 2, length-delimited
+)
+
+// ErrInvalidPublicKey is returned when a serialized public key is malformed
+var ErrInvalidPublicKey = errors.New("peer: invalid protobuf public key")
+
+// String returns the key type name
+func (t KeyType) String() string {
+	switch t {
+	case RSA:
+		return "RSA"
+	case Ed25519:
+		return "Ed25519"
+	case Secp256k1:
+		return "Secp256k1"
+	case ECDSA:
+		return "ECDSA"
+	default:
+		return fmt.Sprintf("KeyType(%d)", uint64(t))
+	}
+}
+
+// MarshalPublicKey serializes raw key data as a libp2p PublicKey protobuf
+func MarshalPublicKey(t KeyType, data []byte) []byte {
+	buf := make([]byte, 0, 2+varint.Len(uint64(t))+varint.Len(uint64(len(data)))+len(data))
+	buf = append(buf, tagType)
+	buf = varint.Append(buf, uint64(t))
+	buf = append(buf, tagData)
+	buf = varint.Append(buf, uint64(len(data)))
+	return append(buf, data...)
+}
+
+// UnmarshalPublicKey parses a libp2p PublicKey protobuf in its canonical
+// field order and returns the key type and raw key data
+func UnmarshalPublicKey(buf []byte) (KeyType, []byte, error) {
+	if len(buf) == 0 || buf[0] != tagType {
+		return 0, nil, ErrInvalidPublicKey
+	}
+	t, n, err := varint.Read(buf[1:])
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+	buf = buf[1+n:]
+
+	if len(buf) == 0 || buf[0] != tagData {
+		return 0, nil, ErrInvalidPublicKey
+	}
+	length, n, err := varint.Read(buf[1:])
+	if err != nil {
+		return 0, nil, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+	data := buf[1+n:]
+	if uint64(len(data)) != length {
+		return 0, nil, fmt.Errorf("%w: key data length mismatch", ErrInvalidPublicKey)
+	}
+	return KeyType(t), data, nil
+}
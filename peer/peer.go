@@ -0,0 +1,95 @@
+// Package peer parses, derives and formats libp2p peer IDs in their legacy
+// Base58 form. The CIDv1 (base32 libp2p-key) form is not supported.
+package peer
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/jnst/base58"
+	"github.com/jnst/base58/multihash"
+)
+
+// maxInlineKeyLen is the longest serialized public key that is embedded in
+// the peer ID with the identity hash rather than hashed with sha2-256
+const maxInlineKeyLen = 42
+
+var (
+	// ErrInvalidID is returned when a peer ID is not an identity or
+	// sha2-256 multihash
+	ErrInvalidID = errors.New("peer: invalid peer ID")
+	// ErrNoPublicKey is returned when extracting the key from a hashed peer ID
+	ErrNoPublicKey = errors.New("peer: public key is not embedded in peer ID")
+)
+
+// ID is a libp2p peer ID, holding the binary multihash
+type ID string
+
+// Decode parses a Base58 peer ID such as "12D3KooW..." or "Qm..."
+func Decode(s string) (ID, error) {
+	mh, err := multihash.FromB58String(s)
+	if err != nil {
+		return "", fmt.Errorf("peer: %w", err)
+	}
+	return FromMultihash(mh)
+}
+
+// FromMultihash validates a binary multihash as a peer ID
+func FromMultihash(mh multihash.Multihash) (ID, error) {
+	decoded, err := multihash.Decode(mh)
+	if err != nil {
+		return "", fmt.Errorf("peer: %w", err)
+	}
+
+	switch decoded.Code {
+	case multihash.Identity:
+		if decoded.Length > maxInlineKeyLen {
+			return "", fmt.Errorf("%w: identity multihash longer than %d bytes", ErrInvalidID, maxInlineKeyLen)
+		}
+	case multihash.SHA2_256:
+		if decoded.Length != sha256.Size {
+			return "", fmt.Errorf("%w: sha2-256 digest must be %d bytes", ErrInvalidID, sha256.Size)
+		}
+	default:
+		return "", fmt.Errorf("%w: unsupported multihash 0x%x", ErrInvalidID, decoded.Code)
+	}
+	return ID(mh), nil
+}
+
+// IDFromPublicKey derives the peer ID of a protobuf-encoded public key.
+// Keys of up to 42 bytes are embedded with the identity hash; longer keys,
+// such as RSA, are hashed with sha2-256.
+func IDFromPublicKey(pubKey []byte) ID {
+	code := multihash.SHA2_256
+	if len(pubKey) <= maxInlineKeyLen {
+		code = multihash.Identity
+	}
+
+	// Sum cannot fail for the built-in codes
+	mh, _ := multihash.Sum(pubKey, code)
+	return ID(mh)
+}
+
+// String returns the Base58 encoding of the peer ID
+func (id ID) String() string {
+	return base58.Encode([]byte(id))
+}
+
+// Multihash returns the binary multihash of the peer ID
+func (id ID) Multihash() multihash.Multihash {
+	return multihash.Multihash(id)
+}
+
+// ExtractPublicKey returns the protobuf-encoded public key embedded in an
+// identity peer ID
+func (id ID) ExtractPublicKey() ([]byte, error) {
+	decoded, err := multihash.Decode(id.Multihash())
+	if err != nil {
+		return nil, fmt.Errorf("peer: %w", err)
+	}
+	if decoded.Code != multihash.Identity {
+		return nil, ErrNoPublicKey
+	}
+	return decoded.Digest, nil
+}
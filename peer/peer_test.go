@@ -0,0 +1,105 @@
+package peer
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jnst/base58"
+	"github.com/jnst/base58/multihash"
+)
+
+func TestIDFromPublicKey(t *testing.T) {
+	pubKey, _ := hex.DecodeString("080112201ed1e8fae2c4a144b8be8fd4b47bf3d3b34b871c3cacf6010f0e42d474fce27e")
+	const expected = "12D3KooWBtg3aaRMjxwedh83aGiUkwSxDwUZkzuJcfaqUmo7R3pq"
+
+	id := IDFromPublicKey(pubKey)
+	if id.String() != expected {
+		t.Errorf("IDFromPublicKey() = %s, want %s", id, expected)
+	}
+
+	decoded, err := Decode(expected)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if decoded != id {
+		t.Errorf("Decode() = %x, want %x", decoded, id)
+	}
+
+	extracted, err := decoded.ExtractPublicKey()
+	if err != nil {
+		t.Fatalf("ExtractPublicKey() error = %v", err)
+	}
+	keyType, data, err := UnmarshalPublicKey(extracted)
+	if err != nil {
+		t.Fatalf("UnmarshalPublicKey() error = %v", err)
+	}
+	if keyType != Ed25519 || hex.EncodeToString(data) != "1ed1e8fae2c4a144b8be8fd4b47bf3d3b34b871c3cacf6010f0e42d474fce27e" {
+		t.Errorf("UnmarshalPublicKey() = %v %x", keyType, data)
+	}
+}
+
+func TestEd25519Prefix(t *testing.T) {
+	for i := 0; i < 8; i++ {
+		seed := make([]byte, ed25519.SeedSize)
+		seed[0] = byte(i)
+		pub := ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey)
+
+		id := IDFromPublicKey(MarshalPublicKey(Ed25519, pub))
+		if !strings.HasPrefix(id.String(), "12D3KooW") {
+			t.Errorf("Ed25519 peer ID %s does not start with 12D3KooW", id)
+		}
+	}
+}
+
+func TestHashedPublicKey(t *testing.T) {
+	// RSA keys are too large to inline and are hashed
+	pubKey := MarshalPublicKey(RSA, make([]byte, 270))
+
+	id := IDFromPublicKey(pubKey)
+	if !strings.HasPrefix(id.String(), "Qm") {
+		t.Errorf("hashed peer ID %s does not start with Qm", id)
+	}
+	if _, err := Decode(id.String()); err != nil {
+		t.Errorf("Decode() error = %v", err)
+	}
+	if _, err := id.ExtractPublicKey(); !errors.Is(err, ErrNoPublicKey) {
+		t.Errorf("ExtractPublicKey() error = %v, want %v", err, ErrNoPublicKey)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	sha1, _ := multihash.Sum([]byte("key"), multihash.SHA1)
+	longIdentity := multihash.Encode(make([]byte, 43), multihash.Identity)
+
+	tests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{"invalid character", "12D3KooWBtg3aaRMjxwedh83aGiUkwSxDwUZkzuJcfaqUmo7R3p0", base58.ErrInvalidCharacter},
+		{"sha1 multihash", sha1.B58String(), ErrInvalidID},
+		{"long identity", longIdentity.B58String(), ErrInvalidID},
+		{"truncated", "12D3KooWBtg3aaRMjxwedh83aGiUkwSxDwUZkzuJcfaqUmo7R3p", multihash.ErrLengthMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(tt.input); !errors.Is(err, tt.err) {
+				t.Errorf("Decode(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestUnmarshalPublicKeyErrors(t *testing.T) {
+	valid := MarshalPublicKey(Ed25519, make([]byte, 32))
+
+	for _, input := range [][]byte{nil, valid[:1], valid[:3], valid[:len(valid)-1], append(valid, 0)} {
+		if _, _, err := UnmarshalPublicKey(input); !errors.Is(err, ErrInvalidPublicKey) {
+			t.Errorf("UnmarshalPublicKey(%x) error = %v, want %v", input, err, ErrInvalidPublicKey)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package solana
+
+import (
+	"crypto/sha256"
+	"errors"
+	"math/big"
+)
+
+const (
+	// MaxSeeds is the maximum number of seeds for a program address
+	MaxSeeds = 16
+	// MaxSeedLen is the maximum length of a single seed in bytes
+	MaxSeedLen = 32
+)
+
+// pdaMarker is appended to the hash input of every program derived address
+const pdaMarker = "ProgramDerivedAddress"
+
+var (
+	// ErrMaxSeedsExceeded is returned when more than MaxSeeds seeds are given
+	ErrMaxSeedsExceeded = errors.New("solana: too many seeds")
+	// ErrMaxSeedLenExceeded is returned when a seed is longer than MaxSeedLen
+	ErrMaxSeedLenExceeded = errors.New("solana: seed too long")
+	// ErrOnCurve is returned when the derived address is a valid Ed25519
+	// point and therefore could have a private key
+	ErrOnCurve = errors.New("solana: derived address is on the ed25519 curve")
+	// ErrNoViableBump is returned when no bump seed yields an off-curve address
+	ErrNoViableBump = errors.New("solana: unable to find a viable program address bump seed")
+)
+
+// CreateProgramAddress derives a program address from seeds and a program ID.
+// It returns ErrOnCurve if the result is a valid Ed25519 public key.
+func CreateProgramAddress(seeds [][]byte, programID PublicKey) (PublicKey, error) {
+	if len(seeds) > MaxSeeds {
+		return PublicKey{}, ErrMaxSeedsExceeded
+	}
+
+	h := sha256.New()
+	for _, seed := range seeds {
+		if len(seed) > MaxSeedLen {
+			return PublicKey{}, ErrMaxSeedLenExceeded
+		}
+		h.Write(seed)
+	}
+	h.Write(programID[:])
+	h.Write([]byte(pdaMarker))
+
+	var key PublicKey
+	h.Sum(key[:0])
+	if key.IsOnCurve() {
+		return PublicKey{}, ErrOnCurve
+	}
+	return key, nil
+}
+
+// FindProgramAddress searches bump seeds from 255 down for the first one
+// that, appended to seeds, yields an off-curve program address
+func FindProgramAddress(seeds [][]byte, programID PublicKey) (PublicKey, uint8, error) {
+	if len(seeds) >= MaxSeeds {
+		return PublicKey{}, 0, ErrMaxSeedsExceeded
+	}
+
+	bumped := make([][]byte, len(seeds)+1)
+	copy(bumped, seeds)
+	for bump := 255; bump >= 0; bump-- {
+		bumped[len(seeds)] = []byte{byte(bump)}
+
+		key, err := CreateProgramAddress(bumped, programID)
+		if err == nil {
+			return key, uint8(bump), nil
+		}
+		if !errors.Is(err, ErrOnCurve) {
+			return PublicKey{}, 0, err
+		}
+	}
+	return PublicKey{}, 0, ErrNoViableBump
+}
+
+// Edwards25519 field prime 2^255 - 19 and curve constant d = -121665/121666
+var (
+	fieldPrime = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(19))
+	curveD     = func() *big.Int {
+		d := new(big.Int).ModInverse(big.NewInt(121666), fieldPrime)
+		d.Mul(d, big.NewInt(-121665))
+		return d.Mod(d, fieldPrime)
+	}()
+)
+
+// IsOnCurve reports whether the key decompresses to a point on the Ed25519
+// curve. Like the Solana runtime, the y coordinate is reduced modulo p and
+// the sign bit is ignored.
+func (k PublicKey) IsOnCurve() bool {
+	// The encoding is little-endian y with the sign of x in the top bit
+	var be [PublicKeySize]byte
+	for i, b := range k {
+		be[PublicKeySize-1-i] = b
+	}
+	be[0] &= 0x7f
+	y := new(big.Int).SetBytes(be[:])
+	y.Mod(y, fieldPrime)
+
+	// x^2 = (y^2 - 1) / (d*y^2 + 1) must have a square root
+	y2 := new(big.Int).Mul(y, y)
+	u := new(big.Int).Sub(y2, big.NewInt(1))
+	u.Mod(u, fieldPrime)
+	v := new(big.Int).Mul(curveD, y2)
+	v.Add(v, big.NewInt(1))
+	v.Mod(v, fieldPrime)
+
+	if u.Sign() == 0 {
+		return true
+	}
+	if v.Sign() == 0 {
+		return false
+	}
+	// u/v is a square exactly when u*v is
+	uv := u.Mul(u, v)
+	uv.Mod(uv, fieldPrime)
+	return big.Jacobi(uv, fieldPrime) == 1
+}
@@ -0,0 +1,81 @@
+package solana
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+)
+
+var upgradeableLoader = MustParsePublicKey("BPFLoaderUpgradeab1e11111111111111111111111")
+
+func TestCreateProgramAddress(t *testing.T) {
+	seedKey := MustParsePublicKey("SeedPubey1111111111111111111111111111111111")
+
+	tests := []struct {
+		name     string
+		seeds    [][]byte
+		expected string
+	}{
+		{"empty seed", [][]byte{{}, {1}}, "BwqrghZA2htAcqq8dzP1WDAhTXYTYWj7CHxF5j7TDBAe"},
+		{"unicode seed", [][]byte{[]byte("☉"), {0}}, "13yWmRpaTR4r5nAktwLqMpRNr28tnVUZw26rTvPSSB19"},
+		{"two seeds", [][]byte{[]byte("Talking"), []byte("Squirrels")}, "2fnQrngrQT4SeLcdToJAD96phoEjNL2man2kfRLCASVk"},
+		{"public key seed", [][]byte{seedKey[:], {1}}, "976ymqVnfE32QFe6NfGDctSvVa36LWnvYxhU6G2232YL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := CreateProgramAddress(tt.seeds, upgradeableLoader)
+			if err != nil {
+				t.Fatalf("CreateProgramAddress() error = %v", err)
+			}
+			if key.String() != tt.expected {
+				t.Errorf("CreateProgramAddress() = %s, want %s", key, tt.expected)
+			}
+		})
+	}
+
+	if _, err := CreateProgramAddress([][]byte{make([]byte, MaxSeedLen+1)}, upgradeableLoader); !errors.Is(err, ErrMaxSeedLenExceeded) {
+		t.Errorf("CreateProgramAddress(long seed) error = %v, want %v", err, ErrMaxSeedLenExceeded)
+	}
+	if _, err := CreateProgramAddress(make([][]byte, MaxSeeds+1), upgradeableLoader); !errors.Is(err, ErrMaxSeedsExceeded) {
+		t.Errorf("CreateProgramAddress(too many seeds) error = %v, want %v", err, ErrMaxSeedsExceeded)
+	}
+}
+
+func TestFindProgramAddress(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		seeds := [][]byte{[]byte("Lil'"), []byte("Bits"), {byte(i)}}
+
+		key, bump, err := FindProgramAddress(seeds, upgradeableLoader)
+		if err != nil {
+			t.Fatalf("FindProgramAddress() error = %v", err)
+		}
+		if key.IsOnCurve() {
+			t.Errorf("FindProgramAddress() = %s is on the curve", key)
+		}
+
+		created, err := CreateProgramAddress(append(seeds, []byte{bump}), upgradeableLoader)
+		if err != nil || created != key {
+			t.Errorf("CreateProgramAddress(bump %d) = %s, %v, want %s", bump, created, err, key)
+		}
+	}
+}
+
+func TestIsOnCurve(t *testing.T) {
+	// Real Ed25519 public keys are points on the curve
+	for i := 0; i < 32; i++ {
+		seed := make([]byte, ed25519.SeedSize)
+		seed[0] = byte(i)
+
+		var key PublicKey
+		copy(key[:], ed25519.NewKeyFromSeed(seed).Public().(ed25519.PublicKey))
+		if !key.IsOnCurve() {
+			t.Errorf("IsOnCurve(%s) = false, want true", key)
+		}
+	}
+
+	// Program derived addresses are off the curve by construction
+	if MustParsePublicKey("BwqrghZA2htAcqq8dzP1WDAhTXYTYWj7CHxF5j7TDBAe").IsOnCurve() {
+		t.Error("IsOnCurve(program address) = true, want false")
+	}
+}
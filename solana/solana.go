@@ -0,0 +1,114 @@
+// Package solana parses and formats Solana public keys and signatures and
+// derives program addresses.
+package solana
+
+import (
+	"fmt"
+
+	"github.com/jnst/base58"
+)
+
+const (
+	// PublicKeySize is the length of a public key in bytes
+	PublicKeySize = 32
+	// SignatureSize is the length of a signature in bytes
+	SignatureSize = 64
+)
+
+// PublicKey is a 32-byte Solana account address. Its text and JSON forms
+// are those of base58.Key32.
+type PublicKey [PublicKeySize]byte
+
+// Signature is a 64-byte Ed25519 transaction signature. Its text and JSON
+// forms are those of base58.Key64.
+type Signature [SignatureSize]byte
+
+// ParsePublicKey decodes a Base58 public key, requiring exactly 32 bytes
+func ParsePublicKey(s string) (PublicKey, error) {
+	key, err := base58.Decode32(s)
+	if err != nil {
+		return PublicKey{}, fmt.Errorf("solana: public key: %w", err)
+	}
+	return key, nil
+}
+
+// MustParsePublicKey is like ParsePublicKey but panics on error.
+// It is intended for program IDs and other constants.
+func MustParsePublicKey(s string) PublicKey {
+	key, err := ParsePublicKey(s)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+// ParseSignature decodes a Base58 signature, requiring exactly 64 bytes
+func ParseSignature(s string) (Signature, error) {
+	sig, err := base58.Decode64(s)
+	if err != nil {
+		return Signature{}, fmt.Errorf("solana: signature: %w", err)
+	}
+	return sig, nil
+}
+
+// String returns the Base58 encoding of the key
+func (k PublicKey) String() string {
+	return base58.Key32(k).String()
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (k PublicKey) MarshalText() ([]byte, error) {
+	return base58.Key32(k).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (k *PublicKey) UnmarshalText(text []byte) error {
+	if err := (*base58.Key32)(k).UnmarshalText(text); err != nil {
+		return fmt.Errorf("solana: public key: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (k PublicKey) MarshalJSON() ([]byte, error) {
+	return base58.Key32(k).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the key unchanged.
+func (k *PublicKey) UnmarshalJSON(data []byte) error {
+	if err := (*base58.Key32)(k).UnmarshalJSON(data); err != nil {
+		return fmt.Errorf("solana: public key: %w", err)
+	}
+	return nil
+}
+
+// String returns the Base58 encoding of the signature
+func (s Signature) String() string {
+	return base58.Key64(s).String()
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (s Signature) MarshalText() ([]byte, error) {
+	return base58.Key64(s).MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (s *Signature) UnmarshalText(text []byte) error {
+	if err := (*base58.Key64)(s).UnmarshalText(text); err != nil {
+		return fmt.Errorf("solana: signature: %w", err)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (s Signature) MarshalJSON() ([]byte, error) {
+	return base58.Key64(s).MarshalJSON()
+}
+
+// UnmarshalJSON implements json.Unmarshaler. A JSON null leaves the signature unchanged.
+func (s *Signature) UnmarshalJSON(data []byte) error {
+	if err := (*base58.Key64)(s).UnmarshalJSON(data); err != nil {
+		return fmt.Errorf("solana: signature: %w", err)
+	}
+	return nil
+}
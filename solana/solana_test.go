@@ -0,0 +1,93 @@
+package solana
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jnst/base58"
+)
+
+func TestParsePublicKey(t *testing.T) {
+	const system = "11111111111111111111111111111111"
+
+	key, err := ParsePublicKey(system)
+	if err != nil {
+		t.Fatalf("ParsePublicKey() error = %v", err)
+	}
+	if key != (PublicKey{}) {
+		t.Errorf("ParsePublicKey(%q) = %x, want zero key", system, key)
+	}
+	if key.String() != system {
+		t.Errorf("String() = %q, want %q", key.String(), system)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{"too short", "1111111111111111111111111111111", base58.ErrInvalidLength},
+		{"too long", base58.Encode(make([]byte, 33)), base58.ErrInvalidLength},
+		{"invalid character", "BPFLoaderUpgradeab1e1111111111111111111111O", base58.ErrInvalidCharacter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParsePublicKey(tt.input); !errors.Is(err, tt.err) {
+				t.Errorf("ParsePublicKey(%q) error = %v, want %v", tt.input, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestParseSignature(t *testing.T) {
+	var sig Signature
+	for i := range sig {
+		sig[i] = byte(i + 1)
+	}
+
+	parsed, err := ParseSignature(sig.String())
+	if err != nil {
+		t.Fatalf("ParseSignature() error = %v", err)
+	}
+	if parsed != sig {
+		t.Errorf("ParseSignature() = %x, want %x", parsed, sig)
+	}
+
+	if _, err := ParseSignature(PublicKey{1}.String()); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("ParseSignature(public key) error = %v, want %v", err, base58.ErrInvalidLength)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type transfer struct {
+		From      PublicKey  `json:"from"`
+		Signature Signature  `json:"signature"`
+		Owner     *PublicKey `json:"owner"`
+	}
+
+	in := transfer{
+		From:      MustParsePublicKey("BPFLoaderUpgradeab1e11111111111111111111111"),
+		Signature: Signature{0xff},
+	}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"from":"BPFLoaderUpgradeab1e11111111111111111111111"`) {
+		t.Errorf("Marshal() = %s", data)
+	}
+
+	var out transfer
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if out.From != in.From || out.Signature != in.Signature || out.Owner != nil {
+		t.Errorf("Unmarshal() = %+v, want %+v", out, in)
+	}
+
+	if err := json.Unmarshal([]byte(`{"from":"1111"}`), &out); !errors.Is(err, base58.ErrInvalidLength) {
+		t.Errorf("Unmarshal(short key) error = %v, want %v", err, base58.ErrInvalidLength)
+	}
+}
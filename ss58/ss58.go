@@ -0,0 +1,140 @@
+// Package ss58 encodes and decodes Substrate SS58 addresses: a network
+// prefix, an account payload and a truncated Blake2b-512 checksum.
+package ss58
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jnst/base58"
+)
+
+// Well-known network prefixes
+const (
+	Polkadot  uint16 = 0
+	Kusama    uint16 = 2
+	Substrate uint16 = 42
+)
+
+// MaxPrefix is the largest network prefix SS58 can represent
+const MaxPrefix = 1<<14 - 1
+
+// checksumLens maps each supported payload length to its checksum length.
+// Short payloads are account indices; 32 and 33 bytes are public keys.
+var checksumLens = map[int]int{
+	1:  1,
+	2:  1,
+	4:  1,
+	8:  1,
+	32: 2,
+	33: 2,
+}
+
+var (
+	// ErrInvalidPrefix is returned for reserved or out-of-range network prefixes
+	ErrInvalidPrefix = errors.New("ss58: invalid network prefix")
+	// ErrInvalidLength is returned when the payload length is not supported
+	ErrInvalidLength = errors.New("ss58: invalid payload length")
+)
+
+// Encode returns the SS58 address of payload on the network with the given prefix
+func Encode(prefix uint16, payload []byte) (string, error) {
+	checksumLen, ok := checksumLens[len(payload)]
+	if !ok {
+		return "", fmt.Errorf("%w: %d bytes", ErrInvalidLength, len(payload))
+	}
+
+	version, err := appendPrefix(nil, prefix)
+	if err != nil {
+		return "", err
+	}
+	return base58.CheckEncodeWith(base58.NewSS58Checksummer(checksumLen), version, payload), nil
+}
+
+// Decode parses an SS58 address into its network prefix and payload
+func Decode(s string) (uint16, []byte, error) {
+	data, err := base58.Decode(s)
+	if err != nil {
+		return 0, nil, fmt.Errorf("ss58: %w", err)
+	}
+
+	prefix, prefixLen, err := readPrefix(data)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	// Find the payload length that leaves room for its own checksum
+	rest := len(data) - prefixLen
+	for payloadLen, checksumLen := range checksumLens {
+		if payloadLen+checksumLen != rest {
+			continue
+		}
+
+		body := data[:prefixLen+payloadLen]
+		sum := base58.NewSS58Checksummer(checksumLen).Checksum(body)
+		if string(sum) != string(data[len(body):]) {
+			return 0, nil, fmt.Errorf("ss58: %w", base58.ErrChecksum)
+		}
+		return prefix, body[prefixLen:], nil
+	}
+	return 0, nil, fmt.Errorf("%w: %d bytes after prefix", ErrInvalidLength, rest)
+}
+
+// Reencode returns the same account encoded for a different network
+func Reencode(s string, prefix uint16) (string, error) {
+	_, payload, err := Decode(s)
+	if err != nil {
+		return "", err
+	}
+	return Encode(prefix, payload)
+}
+
+// appendPrefix appends the 1- or 2-byte encoding of a network prefix
+func appendPrefix(dst []byte, prefix uint16) ([]byte, error) {
+	if err := validatePrefix(prefix); err != nil {
+		return nil, err
+	}
+
+	if prefix < 64 {
+		return append(dst, byte(prefix)), nil
+	}
+	first := byte((prefix&0xfc)>>2) | 0x40
+	second := byte(prefix>>8) | byte(prefix&0x03)<<6
+	return append(dst, first, second), nil
+}
+
+// readPrefix decodes the network prefix at the start of data
+func readPrefix(data []byte) (uint16, int, error) {
+	if len(data) == 0 {
+		return 0, 0, fmt.Errorf("%w: empty address", ErrInvalidLength)
+	}
+
+	switch first := data[0]; {
+	case first < 64:
+		prefix := uint16(first)
+		return prefix, 1, validatePrefix(prefix)
+	case first < 128:
+		if len(data) < 2 {
+			return 0, 0, fmt.Errorf("%w: truncated prefix", ErrInvalidLength)
+		}
+		second := data[1]
+		lower := uint16(first&0x3f)<<2 | uint16(second>>6)
+		upper := uint16(second & 0x3f)
+		prefix := lower | upper<<8
+		return prefix, 2, validatePrefix(prefix)
+	default:
+		return 0, 0, fmt.Errorf("%w: first byte 0x%02x is reserved", ErrInvalidPrefix, first)
+	}
+}
+
+// validatePrefix rejects prefixes that cannot be encoded or are reserved
+func validatePrefix(prefix uint16) error {
+	if prefix > MaxPrefix {
+		return fmt.Errorf("%w: %d exceeds %d", ErrInvalidPrefix, prefix, MaxPrefix)
+	}
+	// 46 and 47 are reserved so that addresses never start with those bytes
+	if prefix == 46 || prefix == 47 {
+		return fmt.Errorf("%w: %d is reserved", ErrInvalidPrefix, prefix)
+	}
+	return nil
+}
@@ -0,0 +1,102 @@
+package ss58
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/jnst/base58"
+)
+
+// alice is the well-known development account public key
+var alice, _ = hex.DecodeString("d43593c715fdd31c61141abd04a99fd6822c8558854ccde39a5684e7a56da27d")
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   uint16
+		payload  []byte
+		expected string
+	}{
+		{"substrate", Substrate, alice, "5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY"},
+		{"polkadot", Polkadot, alice, "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5"},
+		{"kusama", Kusama, alice, "HNZata7iMYWmk5RvZRTiAsSDhV8366zq2YGb3tLH5Upf74F"},
+		{"smallest two-byte prefix", 64, alice, "cEaNSpz4PxFcZ7nT1VEKrKewH67rfx6MfcM6yKojyyPz7qaqp"},
+		{"two-byte prefix", 1284, alice, "VdvKmYJfD4VXA9fzz1SbmCo2eYHSzUFbaDCZSuaNKJAe8YNg6"},
+		{"largest prefix", MaxPrefix, alice, "yNa8JpqfFB3q8A29rCwSgxvdU94ufJw2yKKxDgznS5m1PoFvn"},
+		{"account index", Substrate, []byte{1, 2, 3, 4}, "MvAtmUea"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, err := Encode(tt.prefix, tt.payload)
+			if err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			if encoded != tt.expected {
+				t.Errorf("Encode() = %q, want %q", encoded, tt.expected)
+			}
+
+			prefix, payload, err := Decode(tt.expected)
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if prefix != tt.prefix || string(payload) != string(tt.payload) {
+				t.Errorf("Decode() = %d %x, want %d %x", prefix, payload, tt.prefix, tt.payload)
+			}
+		})
+	}
+}
+
+func TestReencode(t *testing.T) {
+	polkadot, err := Reencode("5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQY", Polkadot)
+	if err != nil {
+		t.Fatalf("Reencode() error = %v", err)
+	}
+	if polkadot != "15oF4uVJwmo4TdGW7VfQxNLavjCXviqxT9S1MgbjMNHr6Sp5" {
+		t.Errorf("Reencode() = %q", polkadot)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	reserved := base58.Encode(append([]byte{0x80}, alice...))
+
+	tests := []struct {
+		name string
+		err  error
+		run  func() error
+	}{
+		{"bad checksum", base58.ErrChecksum, func() error {
+			_, _, err := Decode("5GrwvaEF5zXb26Fz9rcQpDWS57CtERHpNehXCPcNoHGKutQZ")
+			return err
+		}},
+		{"reserved first byte", ErrInvalidPrefix, func() error {
+			_, _, err := Decode(reserved)
+			return err
+		}},
+		{"unsupported length", ErrInvalidLength, func() error {
+			_, _, err := Decode(base58.Encode(make([]byte, 20)))
+			return err
+		}},
+		{"reserved prefix", ErrInvalidPrefix, func() error {
+			_, err := Encode(46, alice)
+			return err
+		}},
+		{"prefix too large", ErrInvalidPrefix, func() error {
+			_, err := Encode(MaxPrefix+1, alice)
+			return err
+		}},
+		{"encode unsupported length", ErrInvalidLength, func() error {
+			_, err := Encode(Polkadot, alice[:20])
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.run(); !errors.Is(err, tt.err) {
+				t.Errorf("error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
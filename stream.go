@@ -0,0 +1,237 @@
+package base58
+
+import (
+	"errors"
+	"io"
+	"math/bits"
+)
+
+// Block framing used by the streaming encoder and decoder: every 8 input
+// bytes are encoded as a fixed-width group of 11 characters, left-padded with
+// the zero digit. A trailing partial block of n bytes uses encodedBlockSizes[n]
+// characters.
+const (
+	blockSize        = 8
+	encodedBlockSize = 11
+	// streamBlocks is the number of blocks processed per buffered write or read
+	streamBlocks = 128
+)
+
+var encodedBlockSizes = [blockSize + 1]int{0, 2, 3, 5, 6, 7, 9, 10, 11}
+
+// decodedBlockSize returns the number of bytes encoded by a block of n
+// characters, or -1 if no block has that length
+func decodedBlockSize(n int) int {
+	for size, encodedSize := range encodedBlockSizes {
+		if encodedSize == n {
+			return size
+		}
+	}
+	return -1
+}
+
+// encodeBlock encodes src (at most blockSize bytes) into dst, which must hold
+// exactly encodedBlockSizes[len(src)] bytes
+func (enc *Encoding) encodeBlock(dst, src []byte) {
+	var value uint64
+	for _, b := range src {
+		value = value<<8 | uint64(b)
+	}
+
+	for i := len(dst) - 1; i >= 0; i-- {
+		dst[i] = enc.encode[value%base58]
+		value /= base58
+	}
+}
+
+// decodeBlock decodes a block of src characters into dst, which must hold
+// exactly decodedBlockSize(len(src)) bytes. Offsets of invalid characters
+// are reported relative to src.
+func (enc *Encoding) decodeBlock(dst, src []byte) error {
+	var value uint64
+	for i, char := range src {
+		digit := enc.decodeMap[char]
+		if digit == invalidIndex {
+			return corruptInputError(string(src), i)
+		}
+
+		hi, lo := bits.Mul64(value, base58)
+		lo, carry := bits.Add64(lo, uint64(digit), 0)
+		if hi != 0 || carry != 0 {
+			return ErrInvalidBlock
+		}
+		value = lo
+	}
+
+	if len(dst) < blockSize && value>>(8*len(dst)) != 0 {
+		return ErrInvalidBlock
+	}
+	for i := len(dst) - 1; i >= 0; i-- {
+		dst[i] = byte(value)
+		value >>= 8
+	}
+	return nil
+}
+
+// NewEncoder returns a streaming Base58 encoder using the Bitcoin alphabet
+func NewEncoder(w io.Writer) io.WriteCloser {
+	return BitcoinEncoding.NewEncoder(w)
+}
+
+// NewDecoder returns a streaming Base58 decoder using the Bitcoin alphabet
+func NewDecoder(r io.Reader) io.Reader {
+	return BitcoinEncoding.NewDecoder(r)
+}
+
+// NewEncoder returns a streaming Base58 encoder. Data written to the returned
+// writer is encoded in 8-byte blocks of 11 characters and written to w, so
+// memory use does not depend on the input size. The output is not the same as
+// Encode and must be read back with NewDecoder. The caller must Close the
+// encoder to flush any partially written block.
+func (enc *Encoding) NewEncoder(w io.Writer) io.WriteCloser {
+	return &encoder{enc: enc, w: w}
+}
+
+// NewDecoder returns a streaming Base58 decoder for input produced by
+// NewEncoder. Line breaks in the input are ignored.
+func (enc *Encoding) NewDecoder(r io.Reader) io.Reader {
+	return &decoder{enc: enc, r: r}
+}
+
+type encoder struct {
+	enc  *Encoding
+	w    io.Writer
+	err  error
+	buf  [blockSize]byte
+	nbuf int
+	out  [streamBlocks * encodedBlockSize]byte
+}
+
+func (e *encoder) Write(p []byte) (n int, err error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+
+	// Complete a previously buffered partial block
+	if e.nbuf > 0 {
+		copied := copy(e.buf[e.nbuf:], p)
+		e.nbuf += copied
+		n += copied
+		p = p[copied:]
+		if e.nbuf < blockSize {
+			return n, nil
+		}
+		e.enc.encodeBlock(e.out[:encodedBlockSize], e.buf[:])
+		if _, e.err = e.w.Write(e.out[:encodedBlockSize]); e.err != nil {
+			return n, e.err
+		}
+		e.nbuf = 0
+	}
+
+	// Encode whole blocks directly from p
+	for len(p) >= blockSize {
+		blocks := len(p) / blockSize
+		if blocks > streamBlocks {
+			blocks = streamBlocks
+		}
+		for i := 0; i < blocks; i++ {
+			e.enc.encodeBlock(
+				e.out[i*encodedBlockSize:(i+1)*encodedBlockSize],
+				p[i*blockSize:(i+1)*blockSize],
+			)
+		}
+		if _, e.err = e.w.Write(e.out[:blocks*encodedBlockSize]); e.err != nil {
+			return n, e.err
+		}
+		n += blocks * blockSize
+		p = p[blocks*blockSize:]
+	}
+
+	// Buffer the remainder until more data arrives or the encoder is closed
+	e.nbuf = copy(e.buf[:], p)
+	n += e.nbuf
+	return n, nil
+}
+
+// Close flushes any pending partial block. It does not close the underlying writer.
+func (e *encoder) Close() error {
+	if e.err == nil && e.nbuf > 0 {
+		size := encodedBlockSizes[e.nbuf]
+		e.enc.encodeBlock(e.out[:size], e.buf[:e.nbuf])
+		_, e.err = e.w.Write(e.out[:size])
+		e.nbuf = 0
+	}
+	return e.err
+}
+
+type decoder struct {
+	enc    *Encoding
+	r      io.Reader
+	err    error
+	offset int // input bytes consumed by previous reads
+	block  [encodedBlockSize]byte
+	nblock int
+	in     [streamBlocks * encodedBlockSize]byte
+	outbuf [(streamBlocks + 1) * blockSize]byte
+	out    []byte
+}
+
+func (d *decoder) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		d.fill()
+	}
+
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+// fill reads the next chunk of input and decodes every completed block
+func (d *decoder) fill() {
+	n, err := d.r.Read(d.in[:])
+	out := d.outbuf[:0]
+
+	for i, char := range d.in[:n] {
+		if char == '\r' || char == '\n' {
+			continue
+		}
+		if d.enc.decodeMap[char] == invalidIndex {
+			cerr := corruptInputError(string(d.in[:n]), i)
+			cerr.Offset += d.offset
+			d.out, d.err = out, cerr
+			return
+		}
+		d.block[d.nblock] = char
+		d.nblock++
+		if d.nblock < encodedBlockSize {
+			continue
+		}
+
+		if derr := d.enc.decodeBlock(out[len(out):len(out)+blockSize], d.block[:]); derr != nil {
+			d.out, d.err = out, derr
+			return
+		}
+		out = out[:len(out)+blockSize]
+		d.nblock = 0
+	}
+
+	if errors.Is(err, io.EOF) && d.nblock > 0 {
+		size := decodedBlockSize(d.nblock)
+		if size < 0 {
+			d.out, d.err = out, ErrInvalidBlock
+			return
+		}
+		if derr := d.enc.decodeBlock(out[len(out):len(out)+size], d.block[:d.nblock]); derr != nil {
+			d.out, d.err = out, derr
+			return
+		}
+		out = out[:len(out)+size]
+		d.nblock = 0
+	}
+
+	d.offset += n
+	d.out, d.err = out, err
+}
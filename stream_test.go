@@ -0,0 +1,143 @@
+package base58
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestEncoder(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    []byte
+		expected string
+	}{
+		{
+			name:     "empty",
+			input:    []byte{},
+			expected: "",
+		},
+		{
+			name:     "single zero byte",
+			input:    []byte{0x00},
+			expected: "11",
+		},
+		{
+			name:     "single 0xff byte",
+			input:    []byte{0xFF},
+			expected: "5Q",
+		},
+		{
+			name:     "full zero block",
+			input:    make([]byte, 8),
+			expected: "11111111111",
+		},
+		{
+			name:     "full 0xff block",
+			input:    bytes.Repeat([]byte{0xFF}, 8),
+			expected: "jpXCZedGfVQ",
+		},
+		{
+			name:     "block and partial block",
+			input:    append(bytes.Repeat([]byte{0xFF}, 8), 0x00),
+			expected: "jpXCZedGfVQ11",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := NewEncoder(&buf)
+			if _, err := w.Write(tt.input); err != nil {
+				t.Fatalf("Write unexpected error: %v", err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close unexpected error: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("encoded %v = %q, want %q", tt.input, buf.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestStreamRoundTrip(t *testing.T) {
+	data := generateRandomBytes(10000)
+
+	for _, chunk := range []int{1, 3, 8, 13, 1000, 10000} {
+		var buf bytes.Buffer
+		w := NewEncoder(&buf)
+		for i := 0; i < len(data); i += chunk {
+			end := i + chunk
+			if end > len(data) {
+				end = len(data)
+			}
+			if _, err := w.Write(data[i:end]); err != nil {
+				t.Fatalf("Write unexpected error: %v", err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Close unexpected error: %v", err)
+		}
+
+		decoded, err := io.ReadAll(NewDecoder(iotest.OneByteReader(&buf)))
+		if err != nil {
+			t.Fatalf("chunk %d: decode unexpected error: %v", chunk, err)
+		}
+		if !bytes.Equal(decoded, data) {
+			t.Errorf("chunk %d: round trip mismatch", chunk)
+		}
+	}
+}
+
+func TestDecoderIgnoresLineBreaks(t *testing.T) {
+	decoded, err := io.ReadAll(NewDecoder(strings.NewReader("jpXCZ\r\nedGfVQ\n11\n")))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := append(bytes.Repeat([]byte{0xFF}, 8), 0x00)
+	if !bytes.Equal(decoded, expected) {
+		t.Errorf("decoded = %x, want %x", decoded, expected)
+	}
+}
+
+func TestDecoderErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   error
+	}{
+		{
+			name:  "invalid partial block length",
+			input: "1",
+			err:   ErrInvalidBlock,
+		},
+		{
+			name:  "full block overflow",
+			input: "zzzzzzzzzzz",
+			err:   ErrInvalidBlock,
+		},
+		{
+			name:  "partial block overflow",
+			input: "5R",
+			err:   ErrInvalidBlock,
+		},
+		{
+			name:  "invalid character",
+			input: "jpXCZedGfV0",
+			err:   ErrInvalidCharacter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := io.ReadAll(NewDecoder(strings.NewReader(tt.input)))
+			if !errors.Is(err, tt.err) {
+				t.Errorf("decode %q error = %v, want %v", tt.input, err, tt.err)
+			}
+		})
+	}
+}
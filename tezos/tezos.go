@@ -0,0 +1,176 @@
+// Package tezos encodes and classifies Tezos Base58Check strings, whose
+// multi-byte version prefixes produce readable leads such as "tz1", "KT1",
+// "edpk" and "edsig".
+package tezos
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/jnst/base58"
+)
+
+// Kind identifies the type of object a Tezos string encodes
+type Kind int
+
+// Standard Tezos encodings
+const (
+	Ed25519PublicKeyHash Kind = iota
+	Secp256k1PublicKeyHash
+	P256PublicKeyHash
+	BLS12381PublicKeyHash
+	ContractHash
+	TxRollupHash
+	SmartRollupHash
+	Ed25519PublicKey
+	Secp256k1PublicKey
+	P256PublicKey
+	BLS12381PublicKey
+	Ed25519Seed
+	Ed25519SecretKey
+	Ed25519EncryptedSeed
+	Secp256k1SecretKey
+	P256SecretKey
+	Ed25519Signature
+	Secp256k1Signature
+	P256Signature
+	GenericSignature
+	BlockHash
+	OperationHash
+	OperationListHash
+	OperationListListHash
+	ProtocolHash
+	ContextHash
+	ScriptExprHash
+	ChainID
+	CryptoboxPublicKeyHash
+)
+
+// encoding describes how one Kind is encoded
+type encoding struct {
+	prefix      string
+	description string
+	version     []byte
+	payloadLen  int
+}
+
+var encodings = [...]encoding{
+	Ed25519PublicKeyHash:   {"tz1", "ed25519 public key hash", []byte{6, 161, 159}, 20},
+	Secp256k1PublicKeyHash: {"tz2", "secp256k1 public key hash", []byte{6, 161, 161}, 20},
+	P256PublicKeyHash:      {"tz3", "p256 public key hash", []byte{6, 161, 164}, 20},
+	BLS12381PublicKeyHash:  {"tz4", "bls12-381 public key hash", []byte{6, 161, 166}, 20},
+	ContractHash:           {"KT1", "originated contract hash", []byte{2, 90, 121}, 20},
+	TxRollupHash:           {"txr1", "transaction rollup hash", []byte{1, 128, 120, 31}, 20},
+	SmartRollupHash:        {"sr1", "smart rollup hash", []byte{6, 124, 117}, 20},
+	Ed25519PublicKey:       {"edpk", "ed25519 public key", []byte{13, 15, 37, 217}, 32},
+	Secp256k1PublicKey:     {"sppk", "secp256k1 public key", []byte{3, 254, 226, 86}, 33},
+	P256PublicKey:          {"p2pk", "p256 public key", []byte{3, 178, 139, 127}, 33},
+	BLS12381PublicKey:      {"BLpk", "bls12-381 public key", []byte{6, 149, 135, 204}, 48},
+	Ed25519Seed:            {"edsk", "ed25519 seed", []byte{13, 15, 58, 7}, 32},
+	Ed25519SecretKey:       {"edsk", "ed25519 secret key", []byte{43, 246, 78, 7}, 64},
+	Ed25519EncryptedSeed:   {"edesk", "ed25519 encrypted seed", []byte{7, 90, 60, 179, 41}, 56},
+	Secp256k1SecretKey:     {"spsk", "secp256k1 secret key", []byte{17, 162, 224, 201}, 32},
+	P256SecretKey:          {"p2sk", "p256 secret key", []byte{16, 81, 238, 189}, 32},
+	Ed25519Signature:       {"edsig", "ed25519 signature", []byte{9, 245, 205, 134, 18}, 64},
+	Secp256k1Signature:     {"spsig1", "secp256k1 signature", []byte{13, 115, 101, 19, 63}, 64},
+	P256Signature:          {"p2sig", "p256 signature", []byte{54, 240, 44, 52}, 64},
+	GenericSignature:       {"sig", "generic signature", []byte{4, 130, 43}, 64},
+	BlockHash:              {"B", "block hash", []byte{1, 52}, 32},
+	OperationHash:          {"o", "operation hash", []byte{5, 116}, 32},
+	OperationListHash:      {"Lo", "operation list hash", []byte{133, 233}, 32},
+	OperationListListHash:  {"LLo", "operation list list hash", []byte{29, 159, 109}, 32},
+	ProtocolHash:           {"P", "protocol hash", []byte{2, 170}, 32},
+	ContextHash:            {"Co", "context hash", []byte{79, 199}, 32},
+	ScriptExprHash:         {"expr", "script expression hash", []byte{13, 44, 64, 27}, 32},
+	ChainID:                {"Net", "chain id", []byte{87, 82, 0}, 4},
+	CryptoboxPublicKeyHash: {"id", "cryptobox public key hash", []byte{153, 103}, 16},
+}
+
+var (
+	// ErrUnknownPrefix is returned when no standard prefix and payload
+	// length match the decoded data
+	ErrUnknownPrefix = errors.New("tezos: unknown prefix")
+	// ErrInvalidLength is returned when encoding a payload of the wrong length
+	ErrInvalidLength = errors.New("tezos: invalid payload length")
+	// ErrWrongKind is returned by DecodeKind when the string is of another kind
+	ErrWrongKind = errors.New("tezos: unexpected kind")
+	// ErrUnknownKind is returned when encoding with a Kind outside the table
+	ErrUnknownKind = errors.New("tezos: unknown kind")
+)
+
+// Kinds returns every standard kind in table order
+func Kinds() []Kind {
+	kinds := make([]Kind, len(encodings))
+	for i := range kinds {
+		kinds[i] = Kind(i)
+	}
+	return kinds
+}
+
+// Prefix returns the readable lead of strings of this kind, such as "tz1"
+func (k Kind) Prefix() string {
+	if !k.valid() {
+		return ""
+	}
+	return encodings[k].prefix
+}
+
+// PayloadLen returns the payload length in bytes
+func (k Kind) PayloadLen() int {
+	if !k.valid() {
+		return 0
+	}
+	return encodings[k].payloadLen
+}
+
+// String returns a description of the kind
+func (k Kind) String() string {
+	if !k.valid() {
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+	return encodings[k].description
+}
+
+func (k Kind) valid() bool {
+	return k >= 0 && int(k) < len(encodings)
+}
+
+// Encode returns the Base58Check encoding of payload for the given kind
+func Encode(kind Kind, payload []byte) (string, error) {
+	if !kind.valid() {
+		return "", ErrUnknownKind
+	}
+	e := encodings[kind]
+	if len(payload) != e.payloadLen {
+		return "", fmt.Errorf("%w: %s needs %d bytes, got %d", ErrInvalidLength, e.prefix, e.payloadLen, len(payload))
+	}
+	return base58.CheckEncode(e.version, payload), nil
+}
+
+// Decode classifies a Tezos string and returns its kind and payload
+func Decode(s string) (Kind, []byte, error) {
+	_, data, err := base58.BitcoinEncoding.CheckDecode(s, 0)
+	if err != nil {
+		return 0, nil, fmt.Errorf("tezos: %w", err)
+	}
+
+	for i, e := range encodings {
+		if len(data) == len(e.version)+e.payloadLen && bytes.HasPrefix(data, e.version) {
+			return Kind(i), data[len(e.version):], nil
+		}
+	}
+	return 0, nil, ErrUnknownPrefix
+}
+
+// DecodeKind decodes s and checks that it is of the expected kind
+func DecodeKind(s string, kind Kind) ([]byte, error) {
+	got, payload, err := Decode(s)
+	if err != nil {
+		return nil, err
+	}
+	if got != kind {
+		return nil, fmt.Errorf("%w: expected %s, got %s", ErrWrongKind, kind, got)
+	}
+	return payload, nil
+}
@@ -0,0 +1,83 @@
+package tezos
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jnst/base58"
+)
+
+// TestPrefixTable checks every table entry against the readable lead it
+// must produce, for the smallest and largest payloads
+func TestPrefixTable(t *testing.T) {
+	for _, kind := range Kinds() {
+		for _, fill := range []byte{0x00, 0xff} {
+			payload := bytes.Repeat([]byte{fill}, kind.PayloadLen())
+
+			encoded, err := Encode(kind, payload)
+			if err != nil {
+				t.Fatalf("Encode(%s) error = %v", kind, err)
+			}
+			if !strings.HasPrefix(encoded, kind.Prefix()) {
+				t.Errorf("Encode(%s, %02x...) = %q, want prefix %q", kind, fill, encoded, kind.Prefix())
+			}
+
+			decoded, got, err := Decode(encoded)
+			if err != nil {
+				t.Fatalf("Decode(%q) error = %v", encoded, err)
+			}
+			if decoded != kind || !bytes.Equal(got, payload) {
+				t.Errorf("Decode(%q) = %s, want %s", encoded, decoded, kind)
+			}
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		input string
+		kind  Kind
+	}{
+		{"tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjb", Ed25519PublicKeyHash},
+		{"KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn", ContractHash},
+		{"NetXdQprcVkpaWU", ChainID},
+		{"BLockGenesisGenesisGenesisGenesisGenesisf79b5d1CoW2", BlockHash},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			kind, payload, err := Decode(tt.input)
+			if err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if kind != tt.kind {
+				t.Errorf("Decode() kind = %s, want %s", kind, tt.kind)
+			}
+
+			encoded, err := Encode(kind, payload)
+			if err != nil || encoded != tt.input {
+				t.Errorf("Encode() = %q, %v, want %q", encoded, err, tt.input)
+			}
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, _, err := Decode("tz1VSUr8wwNhLAzempoch5d6hLRiTh8Cjcjc"); !errors.Is(err, base58.ErrChecksum) {
+		t.Errorf("Decode(bad checksum) error = %v, want %v", err, base58.ErrChecksum)
+	}
+	if _, _, err := Decode(base58.CheckEncode([]byte{0x00}, make([]byte, 20))); !errors.Is(err, ErrUnknownPrefix) {
+		t.Errorf("Decode(bitcoin address) error = %v, want %v", err, ErrUnknownPrefix)
+	}
+	if _, err := Encode(Ed25519PublicKey, make([]byte, 20)); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("Encode(short key) error = %v, want %v", err, ErrInvalidLength)
+	}
+	if _, err := Encode(Kind(-1), nil); !errors.Is(err, ErrUnknownKind) {
+		t.Errorf("Encode(Kind(-1)) error = %v, want %v", err, ErrUnknownKind)
+	}
+	if _, err := DecodeKind("KT1PWx2mnDueood7fEmfbBDKx1D9BAnnXitn", Ed25519PublicKeyHash); !errors.Is(err, ErrWrongKind) {
+		t.Errorf("DecodeKind(contract, tz1) error = %v, want %v", err, ErrWrongKind)
+	}
+}
@@ -0,0 +1,223 @@
+package base58
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+)
+
+// Bytes is a byte slice represented as a Base58 string (Bitcoin alphabet) in
+// text, JSON, SQL and flag values
+type Bytes []byte
+
+// Key32 is a 32-byte value, such as a public key, represented as a Base58
+// string (Bitcoin alphabet) in text, JSON, SQL and flag values
+type Key32 [32]byte
+
+// Key64 is a 64-byte value, such as a signature, represented as a Base58
+// string (Bitcoin alphabet) in text, JSON, SQL and flag values
+type Key64 [64]byte
+
+// jsonNull is the JSON encoding of a nil Bytes
+var jsonNull = []byte("null")
+
+// String returns the Base58 encoding of b
+func (b Bytes) String() string {
+	return Encode(b)
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (b Bytes) MarshalText() ([]byte, error) {
+	return AppendEncode(nil, b), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (b *Bytes) UnmarshalText(text []byte) error {
+	decoded, err := Decode(string(text))
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. A nil Bytes is encoded as null.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		return jsonNull, nil
+	}
+	return appendJSONString(b[:]), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. null decodes to a nil Bytes.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	if string(data) == string(jsonNull) {
+		*b = nil
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return b.UnmarshalText([]byte(s))
+}
+
+// Scan implements sql.Scanner for string, []byte and NULL columns
+func (b *Bytes) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*b = nil
+		return nil
+	case string:
+		return b.UnmarshalText([]byte(v))
+	case []byte:
+		return b.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into base58.Bytes", src)
+	}
+}
+
+// Value implements driver.Valuer. A nil Bytes is stored as NULL.
+func (b Bytes) Value() (driver.Value, error) {
+	if b == nil {
+		return nil, nil
+	}
+	return b.String(), nil
+}
+
+// Set implements flag.Value
+func (b *Bytes) Set(s string) error {
+	return b.UnmarshalText([]byte(s))
+}
+
+// String returns the Base58 encoding of k
+func (k Key32) String() string {
+	return Encode32(k)
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (k Key32) MarshalText() ([]byte, error) {
+	return AppendEncode(nil, k[:]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text must decode
+// to exactly 32 bytes.
+func (k *Key32) UnmarshalText(text []byte) error {
+	decoded, err := Decode32(string(text))
+	if err != nil {
+		return err
+	}
+	*k = decoded
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (k Key32) MarshalJSON() ([]byte, error) {
+	return appendJSONString(k[:]), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Like other non-pointer values,
+// null leaves k unchanged.
+func (k *Key32) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONText(data, k)
+}
+
+// Scan implements sql.Scanner for string and []byte columns
+func (k *Key32) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return k.UnmarshalText([]byte(v))
+	case []byte:
+		return k.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into base58.Key32", src)
+	}
+}
+
+// Value implements driver.Valuer
+func (k Key32) Value() (driver.Value, error) {
+	return k.String(), nil
+}
+
+// Set implements flag.Value
+func (k *Key32) Set(s string) error {
+	return k.UnmarshalText([]byte(s))
+}
+
+// String returns the Base58 encoding of k
+func (k Key64) String() string {
+	return Encode64(k)
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (k Key64) MarshalText() ([]byte, error) {
+	return AppendEncode(nil, k[:]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text must decode
+// to exactly 64 bytes.
+func (k *Key64) UnmarshalText(text []byte) error {
+	decoded, err := Decode64(string(text))
+	if err != nil {
+		return err
+	}
+	*k = decoded
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (k Key64) MarshalJSON() ([]byte, error) {
+	return appendJSONString(k[:]), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Like other non-pointer values,
+// null leaves k unchanged.
+func (k *Key64) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONText(data, k)
+}
+
+// Scan implements sql.Scanner for string and []byte columns
+func (k *Key64) Scan(src any) error {
+	switch v := src.(type) {
+	case string:
+		return k.UnmarshalText([]byte(v))
+	case []byte:
+		return k.UnmarshalText(v)
+	default:
+		return fmt.Errorf("cannot scan %T into base58.Key64", src)
+	}
+}
+
+// Value implements driver.Valuer
+func (k Key64) Value() (driver.Value, error) {
+	return k.String(), nil
+}
+
+// Set implements flag.Value
+func (k *Key64) Set(s string) error {
+	return k.UnmarshalText([]byte(s))
+}
+
+// unmarshalJSONText decodes a JSON string into u, leaving u unchanged for null
+func unmarshalJSONText(data []byte, u encoding.TextUnmarshaler) error {
+	if string(data) == string(jsonNull) {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(s))
+}
+
+// appendJSONString returns the Base58 encoding of data as a JSON string.
+// The alphabet needs no escaping.
+func appendJSONString(data []byte) []byte {
+	buf := make([]byte, 0, EncodedLen(len(data))+2)
+	buf = append(buf, '"')
+	buf = AppendEncode(buf, data)
+	return append(buf, '"')
+}
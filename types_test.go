@@ -0,0 +1,185 @@
+package base58
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"testing"
+)
+
+// Compile-time interface checks
+var (
+	_ encoding.TextMarshaler   = Bytes(nil)
+	_ encoding.TextUnmarshaler = (*Bytes)(nil)
+	_ json.Marshaler           = Bytes(nil)
+	_ json.Unmarshaler         = (*Bytes)(nil)
+	_ sql.Scanner              = (*Bytes)(nil)
+	_ driver.Valuer            = Bytes(nil)
+	_ fmt.Stringer             = Bytes(nil)
+	_ flag.Value               = (*Bytes)(nil)
+
+	_ encoding.TextMarshaler   = Key32{}
+	_ encoding.TextUnmarshaler = (*Key32)(nil)
+	_ json.Marshaler           = Key32{}
+	_ json.Unmarshaler         = (*Key32)(nil)
+	_ sql.Scanner              = (*Key32)(nil)
+	_ driver.Valuer            = Key32{}
+	_ fmt.Stringer             = Key32{}
+	_ flag.Value               = (*Key32)(nil)
+)
+
+func TestBytesJSON(t *testing.T) {
+	type record struct {
+		ID    Bytes `json:"id"`
+		Empty Bytes `json:"empty"`
+		Nil   Bytes `json:"nil"`
+	}
+
+	original := record{ID: Bytes("Hello World"), Empty: Bytes{}}
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("Marshal unexpected error: %v", err)
+	}
+	expected := `{"id":"JxF12TrwUP45BMd","empty":"","nil":null}`
+	if string(data) != expected {
+		t.Fatalf("Marshal = %s, want %s", data, expected)
+	}
+
+	var decoded record
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal unexpected error: %v", err)
+	}
+	if !bytes.Equal(decoded.ID, original.ID) || decoded.Empty == nil || decoded.Nil != nil {
+		t.Errorf("Unmarshal = %+v, want %+v", decoded, original)
+	}
+
+	if err := json.Unmarshal([]byte(`{"id":"JxF0"}`), &decoded); !errors.Is(err, ErrInvalidCharacter) {
+		t.Errorf("Unmarshal invalid error = %v, want ErrInvalidCharacter", err)
+	}
+}
+
+func TestBytesSQL(t *testing.T) {
+	value, err := Bytes("Hello World").Value()
+	if err != nil || value != "JxF12TrwUP45BMd" {
+		t.Fatalf("Value() = %v, %v, want %q", value, err, "JxF12TrwUP45BMd")
+	}
+	if value, _ := Bytes(nil).Value(); value != nil {
+		t.Errorf("nil Value() = %v, want nil", value)
+	}
+
+	for _, src := range []any{"JxF12TrwUP45BMd", []byte("JxF12TrwUP45BMd")} {
+		var b Bytes
+		if err := b.Scan(src); err != nil {
+			t.Fatalf("Scan(%v) unexpected error: %v", src, err)
+		}
+		if string(b) != "Hello World" {
+			t.Errorf("Scan(%v) = %q, want %q", src, b, "Hello World")
+		}
+	}
+
+	b := Bytes("x")
+	if err := b.Scan(nil); err != nil || b != nil {
+		t.Errorf("Scan(nil) = %v, %v, want nil", b, err)
+	}
+	if err := b.Scan(42); err == nil {
+		t.Errorf("Scan(42) expected error, got nil")
+	}
+}
+
+func TestBytesFlag(t *testing.T) {
+	var b Bytes
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&b, "id", "identifier")
+
+	if err := fs.Parse([]string{"-id", "JxF12TrwUP45BMd"}); err != nil {
+		t.Fatalf("Parse unexpected error: %v", err)
+	}
+	if b.String() != "JxF12TrwUP45BMd" || string(b) != "Hello World" {
+		t.Errorf("flag value = %q, want %q", b, "Hello World")
+	}
+}
+
+func TestKey32(t *testing.T) {
+	var key Key32
+	for i := range key {
+		key[i] = byte(i)
+	}
+	encoded := Encode(key[:])
+
+	data, err := json.Marshal(key)
+	if err != nil {
+		t.Fatalf("Marshal unexpected error: %v", err)
+	}
+	if string(data) != `"`+encoded+`"` {
+		t.Fatalf("Marshal = %s, want %q", data, encoded)
+	}
+
+	var decoded Key32
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal unexpected error: %v", err)
+	}
+	if decoded != key {
+		t.Errorf("Unmarshal = %x, want %x", decoded, key)
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &decoded); err != nil || decoded != key {
+		t.Errorf("Unmarshal null changed the key or failed: %v", err)
+	}
+
+	var scanned Key32
+	if err := scanned.Scan(encoded); err != nil || scanned != key {
+		t.Errorf("Scan(%q) = %x, %v, want %x", encoded, scanned, err, key)
+	}
+	if value, err := key.Value(); err != nil || value != encoded {
+		t.Errorf("Value() = %v, %v, want %q", value, err, encoded)
+	}
+
+	if err := decoded.Set("JxF12TrwUP45BMd"); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("Set short value error = %v, want ErrInvalidLength", err)
+	}
+}
+
+func TestKey64(t *testing.T) {
+	var sig Key64
+	for i := range sig {
+		sig[i] = byte(255 - i)
+	}
+	encoded := Encode(sig[:])
+
+	data, err := json.Marshal(sig)
+	if err != nil {
+		t.Fatalf("Marshal unexpected error: %v", err)
+	}
+	if string(data) != `"`+encoded+`"` {
+		t.Fatalf("Marshal = %s, want %q", data, encoded)
+	}
+
+	var decoded Key64
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal unexpected error: %v", err)
+	}
+	if decoded != sig {
+		t.Errorf("Unmarshal = %x, want %x", decoded, sig)
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &decoded); err != nil || decoded != sig {
+		t.Errorf("Unmarshal null changed the value or failed: %v", err)
+	}
+
+	var scanned Key64
+	if err := scanned.Scan([]byte(encoded)); err != nil || scanned != sig {
+		t.Errorf("Scan(%q) = %x, %v, want %x", encoded, scanned, err, sig)
+	}
+	if value, err := sig.Value(); err != nil || value != encoded {
+		t.Errorf("Value() = %v, %v, want %q", value, err, encoded)
+	}
+
+	if err := decoded.Set(Key32{1}.String()); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("Set 32-byte value error = %v, want ErrInvalidLength", err)
+	}
+}
@@ -0,0 +1,73 @@
+package base58
+
+// Valid reports whether s contains only characters of the Bitcoin alphabet
+func Valid(s string) bool {
+	return BitcoinEncoding.Valid(s)
+}
+
+// Validate checks s against the Bitcoin alphabet, reporting the first
+// invalid character as a *CorruptInputError
+func Validate(s string) error {
+	return BitcoinEncoding.Validate(s)
+}
+
+// ValidateLen checks that s is the Bitcoin alphabet encoding of exactly n bytes
+func ValidateLen(s string, n int) error {
+	return BitcoinEncoding.ValidateLen(s, n)
+}
+
+// Valid reports whether s contains only characters of the alphabet.
+// It does not allocate.
+func (enc *Encoding) Valid(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if enc.decodeMap[s[i]] == invalidIndex {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks s against the alphabet without decoding it, reporting the
+// first invalid character as a *CorruptInputError
+func (enc *Encoding) Validate(s string) error {
+	for i := 0; i < len(s); i++ {
+		if enc.decodeMap[s[i]] == invalidIndex {
+			return corruptInputError(s, i)
+		}
+	}
+	return nil
+}
+
+// ValidateLen checks that s is the canonical encoding of exactly n bytes,
+// as required for fixed-size values such as keys, and returns
+// ErrInvalidLength otherwise. It computes the decoded length without
+// allocating the decoded bytes.
+func (enc *Encoding) ValidateLen(s string, n int) error {
+	// Reject lengths that cannot possibly match before any arithmetic,
+	// still reporting an invalid character first
+	if enc.minDecodedLen(s) > n || len(s) > EncodedLen(n) {
+		if err := enc.Validate(s); err != nil {
+			return err
+		}
+		return ErrInvalidLength
+	}
+
+	switch n {
+	case 32:
+		var fixed [32]byte
+		return enc.decode32(&fixed, s)
+	case 64:
+		var fixed [64]byte
+		return enc.decode64(&fixed, s)
+	}
+
+	leading, limbs, scratch, err := decodeLimbs(enc, s)
+	defer putLimbs(scratch)
+	if err != nil {
+		return err
+	}
+	if size, _ := decodedSize(leading, limbs); size != n {
+		return ErrInvalidLength
+	}
+	return nil
+}
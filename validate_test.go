@@ -0,0 +1,98 @@
+package base58
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		offset int
+	}{
+		{"empty", "", -1},
+		{"valid", "JxF12TrwUP45BMd", -1},
+		{"zero digit", "JxF12Trw0P45BMd", 8},
+		{"capital O", "O", 0},
+		{"capital I", "1I", 1},
+		{"lowercase l", "11l", 2},
+		{"whitespace", "JxF12 TrwUP45BMd", 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			valid := Valid(tt.input)
+			err := Validate(tt.input)
+
+			if tt.offset < 0 {
+				if !valid || err != nil {
+					t.Errorf("Validate(%q) = %v, %v, want valid", tt.input, valid, err)
+				}
+				return
+			}
+
+			var corrupt *CorruptInputError
+			if valid || !errors.As(err, &corrupt) {
+				t.Fatalf("Validate(%q) = %v, %v, want *CorruptInputError", tt.input, valid, err)
+			}
+			if corrupt.Offset != tt.offset {
+				t.Errorf("Validate(%q) offset = %d, want %d", tt.input, corrupt.Offset, tt.offset)
+			}
+		})
+	}
+}
+
+func TestValidateLen(t *testing.T) {
+	key := Encode(generateRandomBytes(32))
+	sig := Encode(generateRandomBytes(64))
+
+	tests := []struct {
+		name  string
+		input string
+		n     int
+		err   error
+	}{
+		{"hello world", "JxF12TrwUP45BMd", 11, nil},
+		{"hello world too long", "JxF12TrwUP45BMd", 10, ErrInvalidLength},
+		{"hello world too short", "JxF12TrwUP45BMd", 12, ErrInvalidLength},
+		{"leading zeros", "111", 3, nil},
+		{"leading zeros mismatch", "111", 4, ErrInvalidLength},
+		{"empty", "", 0, nil},
+		{"32-byte key", key, 32, nil},
+		{"32-byte key as 33 bytes", key, 33, ErrInvalidLength},
+		{"64-byte signature", sig, 64, nil},
+		{"64-byte signature as 32 bytes", sig, 32, ErrInvalidLength},
+		{"invalid character", "JxF12Trw0P45BMd", 11, ErrInvalidCharacter},
+		{"invalid character and length", strings.Repeat("z", 100) + "0", 11, ErrInvalidCharacter},
+		{"invalid character and length for 32 bytes", strings.Repeat("z", 50) + "0", 32, ErrInvalidCharacter},
+		{"invalid character one past 32 bytes", strings.Repeat("z", 44) + "0", 32, ErrInvalidCharacter},
+		{"invalid character and length for 64 bytes", strings.Repeat("z", 100) + "0", 64, ErrInvalidCharacter},
+		{"too long for 32 bytes", strings.Repeat("z", 50), 32, ErrInvalidLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLen(tt.input, tt.n)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("ValidateLen(%q, %d) = %v, want %v", tt.input, tt.n, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestValidateAllocs(t *testing.T) {
+	input := Encode(generateRandomBytes(100))
+
+	if allocs := testing.AllocsPerRun(100, func() {
+		_ = Validate(input)
+	}); allocs != 0 {
+		t.Errorf("Validate allocs = %v, want 0", allocs)
+	}
+	if allocs := testing.AllocsPerRun(100, func() {
+		_ = ValidateLen(input, 100)
+	}); allocs != 0 {
+		t.Errorf("ValidateLen allocs = %v, want 0", allocs)
+	}
+}
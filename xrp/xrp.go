@@ -0,0 +1,146 @@
+// Package xrp encodes and decodes XRP Ledger account IDs, family seeds and
+// node public keys, which are Base58Check strings in the Ripple alphabet.
+package xrp
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jnst/base58"
+)
+
+const (
+	// AccountIDSize is the length of an account ID in bytes
+	AccountIDSize = 20
+	// SeedSize is the length of seed entropy in bytes
+	SeedSize = 16
+	// NodePublicKeySize is the length of a compressed node public key in bytes
+	NodePublicKeySize = 33
+)
+
+// Version prefixes for each kind of encoded value
+var (
+	accountIDVersion     = []byte{0x00}
+	nodePublicVersion    = []byte{0x1c}
+	secp256k1SeedVersion = []byte{0x21}
+	ed25519SeedVersion   = []byte{0x01, 0xe1, 0x4b}
+)
+
+var (
+	// ErrInvalidVersion is returned when the version prefix does not match
+	// the expected kind of value
+	ErrInvalidVersion = errors.New("xrp: invalid version prefix")
+	// ErrInvalidLength is returned when a payload has the wrong length
+	ErrInvalidLength = errors.New("xrp: invalid payload length")
+)
+
+// KeyType is the signing algorithm a seed derives keys for
+type KeyType int
+
+const (
+	// Secp256k1 seeds start with "s"
+	Secp256k1 KeyType = iota
+	// Ed25519 seeds start with "sEd"
+	Ed25519
+)
+
+// String returns the key type name
+func (t KeyType) String() string {
+	switch t {
+	case Secp256k1:
+		return "secp256k1"
+	case Ed25519:
+		return "ed25519"
+	default:
+		return "unknown"
+	}
+}
+
+// AccountID is the 20-byte identifier behind a classic "r..." address
+type AccountID [AccountIDSize]byte
+
+// DecodeAccountID decodes a classic address
+func DecodeAccountID(s string) (AccountID, error) {
+	payload, err := decode(s, accountIDVersion, AccountIDSize)
+	if err != nil {
+		return AccountID{}, err
+	}
+
+	var id AccountID
+	copy(id[:], payload)
+	return id, nil
+}
+
+// String returns the classic address of the account
+func (a AccountID) String() string {
+	return base58.RippleEncoding.CheckEncode(accountIDVersion, a[:])
+}
+
+// EncodeSeed encodes 16 bytes of seed entropy as a family seed
+func EncodeSeed(entropy []byte, keyType KeyType) (string, error) {
+	if len(entropy) != SeedSize {
+		return "", ErrInvalidLength
+	}
+
+	switch keyType {
+	case Secp256k1:
+		return base58.RippleEncoding.CheckEncode(secp256k1SeedVersion, entropy), nil
+	case Ed25519:
+		return base58.RippleEncoding.CheckEncode(ed25519SeedVersion, entropy), nil
+	default:
+		return "", fmt.Errorf("xrp: unknown key type %d", keyType)
+	}
+}
+
+// DecodeSeed decodes a family seed into its entropy and key type
+func DecodeSeed(s string) ([SeedSize]byte, KeyType, error) {
+	var entropy [SeedSize]byte
+
+	if payload, err := decode(s, ed25519SeedVersion, SeedSize); err == nil {
+		copy(entropy[:], payload)
+		return entropy, Ed25519, nil
+	}
+	payload, err := decode(s, secp256k1SeedVersion, SeedSize)
+	if err != nil {
+		return entropy, 0, err
+	}
+	copy(entropy[:], payload)
+	return entropy, Secp256k1, nil
+}
+
+// EncodeNodePublicKey encodes a 33-byte compressed validator or peer
+// public key as an "n..." string
+func EncodeNodePublicKey(key []byte) (string, error) {
+	if len(key) != NodePublicKeySize {
+		return "", ErrInvalidLength
+	}
+	return base58.RippleEncoding.CheckEncode(nodePublicVersion, key), nil
+}
+
+// DecodeNodePublicKey decodes an "n..." node public key
+func DecodeNodePublicKey(s string) ([NodePublicKeySize]byte, error) {
+	var key [NodePublicKeySize]byte
+
+	payload, err := decode(s, nodePublicVersion, NodePublicKeySize)
+	if err != nil {
+		return key, err
+	}
+	copy(key[:], payload)
+	return key, nil
+}
+
+// decode checks the Base58Check string against an expected version and
+// payload length
+func decode(s string, version []byte, size int) ([]byte, error) {
+	v, payload, err := base58.RippleEncoding.CheckDecode(s, len(version))
+	if err != nil {
+		return nil, fmt.Errorf("xrp: %w", err)
+	}
+	if string(v) != string(version) {
+		return nil, ErrInvalidVersion
+	}
+	if len(payload) != size {
+		return nil, ErrInvalidLength
+	}
+	return payload, nil
+}
@@ -0,0 +1,129 @@
+package xrp
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/jnst/base58"
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestAccountID(t *testing.T) {
+	tests := []struct {
+		id      string
+		address string
+	}{
+		{"0000000000000000000000000000000000000000", "rrrrrrrrrrrrrrrrrrrrrhoLvTp"},
+		{"0000000000000000000000000000000000000001", "rrrrrrrrrrrrrrrrrrrrBZbvji"},
+		{"ba8e78626ee42c41b46d46c3048df3a1c3c87072", "rJrRMgiRgrU6hDF4pgu5DXQdWyPbY35ErN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			var id AccountID
+			copy(id[:], mustHex(t, tt.id))
+			if id.String() != tt.address {
+				t.Errorf("String() = %q, want %q", id.String(), tt.address)
+			}
+
+			decoded, err := DecodeAccountID(tt.address)
+			if err != nil {
+				t.Fatalf("DecodeAccountID() error = %v", err)
+			}
+			if decoded != id {
+				t.Errorf("DecodeAccountID() = %x, want %s", decoded, tt.id)
+			}
+		})
+	}
+}
+
+func TestSeed(t *testing.T) {
+	tests := []struct {
+		entropy string
+		keyType KeyType
+		seed    string
+	}{
+		{"dedce9ce67b451d852fd4e846fcde31c", Secp256k1, "snoPBrXtMeMyMHUVTgbuqAfg1SUTb"},
+		{"cf2de378fbdd7e2ee87d486dfb5a7bff", Secp256k1, "sn259rEFXrQrWyx3Q7XneWcwV6dfL"},
+		{"4c3a1d213fbdfb14c7c28d609469b341", Ed25519, "sEdTM1uX8pu2do5XvTnutH6HsouMaM2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.seed, func(t *testing.T) {
+			seed, err := EncodeSeed(mustHex(t, tt.entropy), tt.keyType)
+			if err != nil {
+				t.Fatalf("EncodeSeed() error = %v", err)
+			}
+			if seed != tt.seed {
+				t.Errorf("EncodeSeed() = %q, want %q", seed, tt.seed)
+			}
+
+			entropy, keyType, err := DecodeSeed(tt.seed)
+			if err != nil {
+				t.Fatalf("DecodeSeed() error = %v", err)
+			}
+			if hex.EncodeToString(entropy[:]) != tt.entropy || keyType != tt.keyType {
+				t.Errorf("DecodeSeed() = %x %v, want %s %v", entropy, keyType, tt.entropy, tt.keyType)
+			}
+		})
+	}
+}
+
+func TestNodePublicKey(t *testing.T) {
+	key := mustHex(t, "0388e5ba87a000cb807240df8c848eb0b5ffa5c8e5a521bc8e105c0f0a44217828")
+	const encoded = "n9MXXueo837zYH36DvMc13BwHcqtfAWNJY5czWVbp7uYTj7x17TH"
+
+	s, err := EncodeNodePublicKey(key)
+	if err != nil {
+		t.Fatalf("EncodeNodePublicKey() error = %v", err)
+	}
+	if s != encoded {
+		t.Errorf("EncodeNodePublicKey() = %q, want %q", s, encoded)
+	}
+
+	decoded, err := DecodeNodePublicKey(encoded)
+	if err != nil {
+		t.Fatalf("DecodeNodePublicKey() error = %v", err)
+	}
+	if string(decoded[:]) != string(key) {
+		t.Errorf("DecodeNodePublicKey() = %x, want %x", decoded, key)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	t.Run("bitcoin alphabet", func(t *testing.T) {
+		bitcoin := base58.CheckEncode([]byte{0x00}, make([]byte, AccountIDSize))
+		if _, err := DecodeAccountID(bitcoin); err == nil {
+			t.Errorf("DecodeAccountID(%q) succeeded for a Bitcoin alphabet address", bitcoin)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		decode func() error
+		err    error
+	}{
+		{"seed as account", func() error { _, err := DecodeAccountID("snoPBrXtMeMyMHUVTgbuqAfg1SUTb"); return err }, ErrInvalidVersion},
+		{"account as seed", func() error { _, _, err := DecodeSeed("rJrRMgiRgrU6hDF4pgu5DXQdWyPbY35ErN"); return err }, ErrInvalidVersion},
+		{"account as node key", func() error { _, err := DecodeNodePublicKey("rJrRMgiRgrU6hDF4pgu5DXQdWyPbY35ErN"); return err }, ErrInvalidVersion},
+		{"bad checksum", func() error { _, err := DecodeAccountID("rJrRMgiRgrU6hDF4pgu5DXQdWyPbY35ErM"); return err }, base58.ErrChecksum},
+		{"short entropy", func() error { _, err := EncodeSeed(make([]byte, 15), Secp256k1); return err }, ErrInvalidLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.decode(); !errors.Is(err, tt.err) {
+				t.Errorf("error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}